@@ -0,0 +1,179 @@
+package common
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// CSVMapper converts CSV records into structs of type T, matching each
+// header column to the exported field tagged `csv:"name"`, or to the field
+// of the same name when untagged. Fields tagged `csv:"-"` are ignored, as
+// are columns with no matching field.
+//
+// String, integer, float, bool and time.Time fields are supported. Times are
+// parsed with the layout in a `layout:"..."` tag, defaulting to RFC 3339.
+// Empty cells leave the field at its zero value.
+type CSVMapper[T any] struct {
+	columns []csvColumn
+}
+
+type csvColumn struct {
+	name  string
+	index []int
+	parse func(string, reflect.Value) error
+}
+
+var timeType = reflect.TypeOf(time.Time{})
+
+func NewCSVMapper[T any](header []string) (*CSVMapper[T], error) {
+	typ := reflect.TypeOf((*T)(nil)).Elem()
+	if typ.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("golinq: cannot map csv to non-struct type %v", typ)
+	}
+
+	fields := map[string]reflect.StructField{}
+	for i := 0; i < typ.NumField(); i++ {
+		field := typ.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+		name := field.Tag.Get("csv")
+		if name == "-" {
+			continue
+		}
+		if name == "" {
+			name = field.Name
+		}
+		fields[name] = field
+	}
+
+	columns := make([]csvColumn, len(header))
+	for i, name := range header {
+		if i == 0 {
+			name = strings.TrimPrefix(name, "\ufeff") // byte order mark
+		}
+		name = strings.TrimSpace(name)
+		columns[i].name = name
+
+		field, ok := fields[name]
+		if !ok {
+			continue
+		}
+		parse, err := csvParser(field)
+		if err != nil {
+			return nil, err
+		}
+		columns[i].index = field.Index
+		columns[i].parse = parse
+	}
+
+	return &CSVMapper[T]{columns: columns}, nil
+}
+
+func (x *CSVMapper[T]) Map(record []string) (T, error) {
+	var result T
+	target := reflect.ValueOf(&result).Elem()
+
+	for i, column := range x.columns {
+		if i >= len(record) {
+			break
+		}
+		if column.parse == nil {
+			continue
+		}
+		err := column.parse(record[i], target.FieldByIndex(column.index))
+		if err != nil {
+			var none T
+			return none, fmt.Errorf("column %q: %w", column.name, err)
+		}
+	}
+
+	return result, nil
+}
+
+func csvParser(field reflect.StructField) (func(string, reflect.Value) error, error) {
+	if field.Type == timeType {
+		layout := field.Tag.Get("layout")
+		if layout == "" {
+			layout = time.RFC3339
+		}
+		return func(s string, v reflect.Value) error {
+			s = strings.TrimSpace(s)
+			if s == "" {
+				return nil
+			}
+			parsed, err := time.Parse(layout, s)
+			if err != nil {
+				return err
+			}
+			v.Set(reflect.ValueOf(parsed))
+			return nil
+		}, nil
+	}
+
+	bits := field.Type.Bits
+	switch field.Type.Kind() {
+	case reflect.String:
+		return func(s string, v reflect.Value) error {
+			v.SetString(s)
+			return nil
+		}, nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return func(s string, v reflect.Value) error {
+			s = strings.TrimSpace(s)
+			if s == "" {
+				return nil
+			}
+			parsed, err := strconv.ParseInt(s, 10, bits())
+			if err != nil {
+				return err
+			}
+			v.SetInt(parsed)
+			return nil
+		}, nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return func(s string, v reflect.Value) error {
+			s = strings.TrimSpace(s)
+			if s == "" {
+				return nil
+			}
+			parsed, err := strconv.ParseUint(s, 10, bits())
+			if err != nil {
+				return err
+			}
+			v.SetUint(parsed)
+			return nil
+		}, nil
+	case reflect.Float32, reflect.Float64:
+		return func(s string, v reflect.Value) error {
+			s = strings.TrimSpace(s)
+			if s == "" {
+				return nil
+			}
+			parsed, err := strconv.ParseFloat(s, bits())
+			if err != nil {
+				return err
+			}
+			v.SetFloat(parsed)
+			return nil
+		}, nil
+	case reflect.Bool:
+		return func(s string, v reflect.Value) error {
+			s = strings.TrimSpace(s)
+			if s == "" {
+				return nil
+			}
+			parsed, err := strconv.ParseBool(s)
+			if err != nil {
+				return err
+			}
+			v.SetBool(parsed)
+			return nil
+		}, nil
+	}
+
+	return nil, fmt.Errorf("golinq: unsupported csv field type %v for field %s", field.Type, field.Name)
+}
@@ -4,3 +4,29 @@ type KeyValuePair[T_Key comparable, T_Value any] struct {
 	Key   T_Key
 	Value T_Value
 }
+
+type Indexed[T any] struct {
+	Index int
+	Value T
+}
+
+type Pair[T_First any, T_Second any] struct {
+	First  T_First
+	Second T_Second
+}
+
+type Triple[T_First any, T_Second any, T_Third any] struct {
+	First  T_First
+	Second T_Second
+	Third  T_Third
+}
+
+// Grouping is a key and the items that share it, in source order. It lives
+// here so that both packages can share it, which is also why it is not
+// itself enumerable: common cannot import iterators or enumerables without
+// an import cycle. Use iterators.FromGrouping or enumerables.FromGrouping to
+// query the items of a group.
+type Grouping[T_Key comparable, T_Value any] struct {
+	Key   T_Key
+	Items []T_Value
+}
@@ -0,0 +1,85 @@
+package common
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// ErrAlreadyEnumerated is the panic value raised when a single-use source,
+// such as one reading from a channel, is enumerated a second time.
+var ErrAlreadyEnumerated = errors.New("golinq: single-use source has already been enumerated")
+
+// ErrNotOrdered is the panic value raised when ThenBy is applied to a
+// sequence that did not come from OrderBy or another ThenBy.
+var ErrNotOrdered = errors.New("golinq: ThenBy requires a sequence returned by OrderBy")
+
+// ErrInvalidWindow is the panic value raised when Window is given a zero
+// size or step.
+var ErrInvalidWindow = errors.New("golinq: window size and step must be positive")
+
+// ErrorPolicy decides what a source does with a row it cannot read or convert.
+type ErrorPolicy int
+
+const (
+	// StopOnError ends enumeration at the first bad row and reports it.
+	StopOnError ErrorPolicy = iota
+	// SkipErrors drops bad rows silently and carries on.
+	SkipErrors
+	// CollectErrors drops bad rows and reports them all once the source is exhausted.
+	CollectErrors
+)
+
+// RowError is a failure to read or convert a single row of a source.
+type RowError struct {
+	Line int
+	Err  error
+}
+
+func (e *RowError) Error() string {
+	return fmt.Sprintf("golinq: line %d: %v", e.Line, e.Err)
+}
+
+func (e *RowError) Unwrap() error {
+	return e.Err
+}
+
+// RowErrors is every RowError gathered under CollectErrors.
+type RowErrors []*RowError
+
+func (e RowErrors) Error() string {
+	messages := make([]string, len(e))
+	for i, x := range e {
+		messages[i] = x.Error()
+	}
+	return strings.Join(messages, "; ")
+}
+
+// DecodeError is a failure to decode the element at Index of a stream.
+// Offset is the byte offset into the input where the failing element, or
+// the syntax error within it, was found.
+type DecodeError struct {
+	Index  int
+	Offset int64
+	Err    error
+}
+
+func (e *DecodeError) Error() string {
+	return fmt.Sprintf("golinq: element %d at offset %d: %v", e.Index, e.Offset, e.Err)
+}
+
+func (e *DecodeError) Unwrap() error {
+	return e.Err
+}
+
+// CastError is a failure to convert the element at Index of a sequence.
+// Type and Target are the element's dynamic type and the requested type.
+type CastError struct {
+	Index  int
+	Type   string
+	Target string
+}
+
+func (e *CastError) Error() string {
+	return fmt.Sprintf("golinq: element %d of type %s cannot be cast to %s", e.Index, e.Type, e.Target)
+}
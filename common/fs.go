@@ -0,0 +1,30 @@
+package common
+
+import "io/fs"
+
+// FSEntry is a file or directory found by walking a file system. Depth is 0
+// for the root of the walk and increases by one per directory level.
+type FSEntry struct {
+	Path  string
+	Entry fs.DirEntry
+	Depth int
+}
+
+type FSOptions struct {
+	// Pattern, when set, only yields entries whose name matches it, as
+	// for path.Match. Directories that do not match are still walked.
+	Pattern string
+	// MaxDepth, when above zero, stops the walk at entries of that depth.
+	MaxDepth int
+	// SkipDir, when set, is called for each directory as the walk is
+	// about to descend into it; returning true skips its subtree. For
+	// a directory that is yielded, the call happens only after its own
+	// entry has been handed over: for an Iterator, once the next entry
+	// is requested, so it may depend on what the consumer has done with
+	// the directory's entry; for an Enumerable, as soon as the entry has
+	// been received. An Enumerable calls it on the goroutine walking the
+	// tree, concurrently with the rest of the pipeline, so any state it
+	// shares with pipeline callbacks must be goroutine-safe, and it may
+	// run before later operations have finished with the entry.
+	SkipDir func(FSEntry) bool
+}
@@ -0,0 +1,106 @@
+package enumerables
+
+import (
+	"fmt"
+	"reflect"
+
+	cmn "github.com/alexmacinnes/golinq/common"
+)
+
+type enumerableOfType[T_In any, T_Out any] struct {
+	Prior Enumerable[T_In]
+}
+
+func (this *enumerableOfType[T_In, T_Out]) getAction() *actionDelegate[T_Out] {
+	actionDelegate, ctx := newActionDelegate[T_Out]()
+	priorAction := this.Prior.getAction()
+
+	action := func() {
+		defer close(actionDelegate.ResultChannel)
+
+		go priorAction.Action()
+		chanIn := priorAction.ResultChannel
+
+		for x := range chanIn {
+			if actionIsCancelled(ctx) {
+				priorAction.CancelFunc() // cancel the prior operation
+				break
+			}
+			if converted, ok := any(x).(T_Out); ok {
+				if !sendResult(ctx, actionDelegate.ResultChannel, converted) {
+					priorAction.CancelFunc() // cancel the prior operation
+					break
+				}
+			}
+		}
+	}
+	actionDelegate.Action = action
+	releaseUnstarted(actionDelegate, priorAction.CancelFunc)
+
+	return actionDelegate
+}
+
+// OfType yields the items of prior that hold a T_Out, skipping the rest.
+func OfType[T_In any, T_Out any](prior Enumerable[T_In]) Enumerable[T_Out] {
+	return &enumerableOfType[T_In, T_Out]{
+		Prior: prior,
+	}
+}
+
+type enumerableCast[T_In any, T_Out any] struct {
+	sourceError
+	Prior Enumerable[T_In]
+}
+
+func (this *enumerableCast[T_In, T_Out]) getAction() *actionDelegate[T_Out] {
+	actionDelegate, ctx := newActionDelegate[T_Out]()
+	priorAction := this.Prior.getAction()
+	this.setErr(nil)
+
+	action := func() {
+		defer close(actionDelegate.ResultChannel)
+
+		go priorAction.Action()
+		chanIn := priorAction.ResultChannel
+
+		index := 0
+		for x := range chanIn {
+			if actionIsCancelled(ctx) {
+				priorAction.CancelFunc() // cancel the prior operation
+				break
+			}
+			converted, ok := any(x).(T_Out)
+			if !ok {
+				this.setErr(&cmn.CastError{
+					Index:  index,
+					Type:   fmt.Sprintf("%T", x),
+					Target: reflect.TypeOf((*T_Out)(nil)).Elem().String(),
+				})
+				priorAction.CancelFunc() // cancel the prior operation
+				break
+			}
+			index++
+			if !sendResult(ctx, actionDelegate.ResultChannel, converted) {
+				priorAction.CancelFunc() // cancel the prior operation
+				break
+			}
+		}
+	}
+	actionDelegate.Action = action
+	releaseUnstarted(actionDelegate, priorAction.CancelFunc)
+
+	return actionDelegate
+}
+
+// Cast converts every item of prior to T_Out. An item that does not hold a
+// T_Out truncates the sequence: enumeration ends there, and the returned
+// error func reports a *cmn.CastError until prior is enumerated again.
+// Always check it after enumerating, or use OfType to skip such items.
+// Unlike iterators.Cast this cannot panic, as the conversion runs on a
+// goroutine the caller could not recover it from.
+func Cast[T_In any, T_Out any](prior Enumerable[T_In]) (Enumerable[T_Out], func() error) {
+	result := &enumerableCast[T_In, T_Out]{
+		Prior: prior,
+	}
+	return result, result.Err
+}
@@ -7,12 +7,11 @@ type enumerableChunk[T any] struct {
 
 func (this *enumerableChunk[T]) getAction() *actionDelegate[[]T] {
 	actionDelegate, ctx := newActionDelegate[[]T]()
+	priorAction := this.Prior.getAction()
 
 	action := func() {
 		defer close(actionDelegate.ResultChannel)
 
-		priorAction := this.Prior.getAction()
-
 		go priorAction.Action()
 		chanIn := priorAction.ResultChannel
 
@@ -29,18 +28,22 @@ func (this *enumerableChunk[T]) getAction() *actionDelegate[[]T] {
 			currentCount++
 
 			if currentCount == int(this.ChunkSize) {
-				actionDelegate.ResultChannel <- currentChunk
+				if !sendResult(ctx, actionDelegate.ResultChannel, currentChunk) {
+					priorAction.CancelFunc() // cancel the prior operation
+					return
+				}
 				currentChunk = []T{}
 				currentCount = 0
 			}
 		}
 
 		if currentCount > 0 {
-			actionDelegate.ResultChannel <- currentChunk
+			sendResult(ctx, actionDelegate.ResultChannel, currentChunk)
 		}
 
 	}
 	actionDelegate.Action = action
+	releaseUnstarted(actionDelegate, priorAction.CancelFunc)
 
 	return actionDelegate
 }
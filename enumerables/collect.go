@@ -58,6 +58,10 @@ func ElementAt[T any](src Enumerable[T], index int) (T, bool) {
 }
 
 func First[T any](src Enumerable[T]) (T, bool) {
+	if ordered, ok := src.(OrderedEnumerable[T]); ok {
+		return ordered.first()
+	}
+
 	resultChannel, cancelFunc := runAction(src)
 
 	result, ok := consumeFirst(resultChannel)
@@ -67,6 +71,11 @@ func First[T any](src Enumerable[T]) (T, bool) {
 }
 
 func FirstOrDefault[T any](src Enumerable[T]) T {
+	if ordered, ok := src.(OrderedEnumerable[T]); ok {
+		result, _ := ordered.first()
+		return result
+	}
+
 	resultChannel, cancelFunc := runAction(src)
 
 	result, _ := consumeFirst(resultChannel)
@@ -84,6 +93,7 @@ func Single[T any](src Enumerable[T]) (T, bool) {
 		// fail result if there is a second item in result channel
 		_, nextOk := consumeFirst(resultChannel)
 		if nextOk {
+			cancelFunc()
 			var defaultValue T
 			return defaultValue, false
 		}
@@ -103,6 +113,7 @@ func SingleOrDefault[T any](src Enumerable[T]) (T, bool) {
 		// fail result if there is a second item in result channel
 		_, nextOk := consumeFirst(resultChannel)
 		if nextOk {
+			cancelFunc()
 			var defaultValue T
 			return defaultValue, false
 		}
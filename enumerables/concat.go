@@ -0,0 +1,65 @@
+package enumerables
+
+type enumerableConcat[T any] struct {
+	Priors []Enumerable[T]
+}
+
+func (this *enumerableConcat[T]) getAction() *actionDelegate[T] {
+	priorActions := make([]*actionDelegate[T], len(this.Priors))
+	for i, x := range this.Priors {
+		priorActions[i] = x.getAction()
+	}
+	actionDelegate, ctx := newActionDelegate[T]()
+
+	action := func() {
+		defer close(actionDelegate.ResultChannel)
+
+		// only one prior runs at a time, but those not yet started still
+		// need their contexts released when this action stops early
+		cancelFrom := func(i int) {
+			for _, x := range priorActions[i:] {
+				x.CancelFunc()
+			}
+		}
+
+		for i, priorAction := range priorActions {
+			go priorAction.Action()
+			chanIn := priorAction.ResultChannel
+
+			for x := range chanIn {
+				if actionIsCancelled(ctx) {
+					cancelFrom(i) // cancel the prior operations
+					return
+				}
+				if !sendResult(ctx, actionDelegate.ResultChannel, x) {
+					cancelFrom(i) // cancel the prior operations
+					return
+				}
+			}
+		}
+	}
+	actionDelegate.Action = action
+	cancelFuncs := make([]func(), len(priorActions))
+	for i, x := range priorActions {
+		cancelFuncs[i] = x.CancelFunc
+	}
+	releaseUnstarted(actionDelegate, cancelFuncs...)
+
+	return actionDelegate
+}
+
+// Concat yields every item of each prior in turn. A prior is not started
+// until the one before it is exhausted.
+func Concat[T any](priors ...Enumerable[T]) Enumerable[T] {
+	return &enumerableConcat[T]{
+		Priors: priors,
+	}
+}
+
+func Append[T any](prior Enumerable[T], items ...T) Enumerable[T] {
+	return Concat(prior, FromSlice(&items))
+}
+
+func Prepend[T any](prior Enumerable[T], items ...T) Enumerable[T] {
+	return Concat(FromSlice(&items), prior)
+}
@@ -0,0 +1,129 @@
+package enumerables
+
+import (
+	"encoding/csv"
+	"errors"
+	"io"
+	"sync/atomic"
+
+	cmn "github.com/alexmacinnes/golinq/common"
+)
+
+type enumerableFromCSV[T any] struct {
+	sourceError
+	Reader     *csv.Reader
+	Policy     cmn.ErrorPolicy
+	ReadHeader func([]string) error
+	Parse      func([]string) (T, error)
+	consumed   atomic.Bool
+}
+
+func (this *enumerableFromCSV[T]) getAction() *actionDelegate[T] {
+	if this.consumed.Swap(true) {
+		panic(cmn.ErrAlreadyEnumerated)
+	}
+
+	actionDelegate, ctx := newActionDelegate[T]()
+
+	action := func() {
+		defer close(actionDelegate.ResultChannel)
+
+		collected := cmn.RowErrors{}
+		headerRead := this.ReadHeader == nil
+
+		for {
+			if actionIsCancelled(ctx) {
+				return // abort the current operation
+			}
+
+			record, err := this.Reader.Read()
+			if err == io.EOF {
+				break
+			}
+
+			var line int
+			var parseErr *csv.ParseError
+			if errors.As(err, &parseErr) {
+				line, err = parseErr.Line, parseErr.Err
+			} else if err != nil {
+				this.setErr(err) // the reader itself failed, so no later rows can be read
+				return
+			} else {
+				line, _ = this.Reader.FieldPos(0)
+			}
+
+			if !headerRead {
+				headerRead = true
+				if err == nil {
+					err = this.ReadHeader(record)
+				}
+				if err != nil {
+					this.setErr(&cmn.RowError{Line: line, Err: err})
+					return
+				}
+				continue
+			}
+
+			var result T
+			if err == nil {
+				result, err = this.Parse(record)
+			}
+			if err == nil {
+				if !sendResult(ctx, actionDelegate.ResultChannel, result) {
+					return // abort the current operation
+				}
+				continue
+			}
+
+			rowErr := &cmn.RowError{Line: line, Err: err}
+			switch this.Policy {
+			case cmn.SkipErrors:
+			case cmn.CollectErrors:
+				collected = append(collected, rowErr)
+			default:
+				this.setErr(rowErr)
+				return
+			}
+		}
+
+		if len(collected) > 0 {
+			this.setErr(collected)
+		}
+	}
+	actionDelegate.Action = action
+
+	return actionDelegate
+}
+
+// FromCSVRecords yields every record of reader, including any header row.
+// Malformed rows are handled according to policy and reported through the
+// returned error func. The reader can only be read once, so the result is
+// single-use.
+func FromCSVRecords(reader *csv.Reader, policy cmn.ErrorPolicy) (Enumerable[[]string], func() error) {
+	result := &enumerableFromCSV[[]string]{
+		Reader: reader,
+		Policy: policy,
+		Parse:  func(record []string) ([]string, error) { return record, nil },
+	}
+	return result, result.Err
+}
+
+// FromCSV reads a header row from reader and maps each following record
+// into a T, as described by cmn.CSVMapper. Rows that fail to parse are
+// handled according to policy and reported through the returned error func.
+// The reader can only be read once, so the result is single-use.
+func FromCSV[T any](reader *csv.Reader, policy cmn.ErrorPolicy) (Enumerable[T], func() error) {
+	var mapper *cmn.CSVMapper[T]
+
+	result := &enumerableFromCSV[T]{
+		Reader: reader,
+		Policy: policy,
+		ReadHeader: func(header []string) error {
+			var err error
+			mapper, err = cmn.NewCSVMapper[T](header)
+			return err
+		},
+		Parse: func(record []string) (T, error) { return mapper.Map(record) },
+	}
+	return result, result.Err
+}
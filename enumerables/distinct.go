@@ -6,14 +6,13 @@ type enumerableDistinct[T comparable] struct {
 
 func (this *enumerableDistinct[T]) getAction() *actionDelegate[T] {
 	actionDelegate, ctx := newActionDelegate[T]()
+	priorAction := this.Prior.getAction()
 
 	action := func() {
 		defer close(actionDelegate.ResultChannel)
 
 		previousItems := make(map[T]bool)
 
-		priorAction := this.Prior.getAction()
-
 		go priorAction.Action()
 		chanIn := priorAction.ResultChannel
 
@@ -24,11 +23,15 @@ func (this *enumerableDistinct[T]) getAction() *actionDelegate[T] {
 			}
 			if !previousItems[x] {
 				previousItems[x] = true
-				actionDelegate.ResultChannel <- x
+				if !sendResult(ctx, actionDelegate.ResultChannel, x) {
+					priorAction.CancelFunc() // cancel the prior operation
+					break
+				}
 			}
 		}
 	}
 	actionDelegate.Action = action
+	releaseUnstarted(actionDelegate, priorAction.CancelFunc)
 
 	return actionDelegate
 }
@@ -38,3 +41,103 @@ func Distinct[T comparable](prior Enumerable[T]) Enumerable[T] {
 		Prior: prior,
 	}
 }
+
+type enumerableDistinctBy[T any, T_Key comparable] struct {
+	Prior   Enumerable[T]
+	KeyFunc func(T) T_Key
+}
+
+func (this *enumerableDistinctBy[T, T_Key]) getAction() *actionDelegate[T] {
+	actionDelegate, ctx := newActionDelegate[T]()
+	priorAction := this.Prior.getAction()
+
+	action := func() {
+		defer close(actionDelegate.ResultChannel)
+
+		previousKeys := make(map[T_Key]bool)
+
+		go priorAction.Action()
+		chanIn := priorAction.ResultChannel
+
+		for x := range chanIn {
+			if actionIsCancelled(ctx) {
+				priorAction.CancelFunc() // cancel the prior operation
+				break
+			}
+			key := this.KeyFunc(x)
+			if !previousKeys[key] {
+				previousKeys[key] = true
+				if !sendResult(ctx, actionDelegate.ResultChannel, x) {
+					priorAction.CancelFunc() // cancel the prior operation
+					break
+				}
+			}
+		}
+	}
+	actionDelegate.Action = action
+	releaseUnstarted(actionDelegate, priorAction.CancelFunc)
+
+	return actionDelegate
+}
+
+// DistinctBy yields the first item of prior for each key returned by keyFunc.
+func DistinctBy[T any, T_Key comparable](prior Enumerable[T], keyFunc func(T) T_Key) Enumerable[T] {
+	return &enumerableDistinctBy[T, T_Key]{
+		Prior:   prior,
+		KeyFunc: keyFunc,
+	}
+}
+
+type enumerableDistinctUntilChanged[T any, T_Key comparable] struct {
+	Prior   Enumerable[T]
+	KeyFunc func(T) T_Key
+}
+
+func (this *enumerableDistinctUntilChanged[T, T_Key]) getAction() *actionDelegate[T] {
+	actionDelegate, ctx := newActionDelegate[T]()
+	priorAction := this.Prior.getAction()
+
+	action := func() {
+		defer close(actionDelegate.ResultChannel)
+
+		var previousKey T_Key
+		started := false
+
+		go priorAction.Action()
+		chanIn := priorAction.ResultChannel
+
+		for x := range chanIn {
+			if actionIsCancelled(ctx) {
+				priorAction.CancelFunc() // cancel the prior operation
+				break
+			}
+			key := this.KeyFunc(x)
+			if !started || key != previousKey {
+				started = true
+				previousKey = key
+				if !sendResult(ctx, actionDelegate.ResultChannel, x) {
+					priorAction.CancelFunc() // cancel the prior operation
+					break
+				}
+			}
+		}
+	}
+	actionDelegate.Action = action
+	releaseUnstarted(actionDelegate, priorAction.CancelFunc)
+
+	return actionDelegate
+}
+
+// DistinctUntilChanged drops items equal to the item immediately before them.
+func DistinctUntilChanged[T comparable](prior Enumerable[T]) Enumerable[T] {
+	return DistinctUntilChangedBy(prior, func(x T) T { return x })
+}
+
+// DistinctUntilChangedBy drops items whose key equals the key of the item
+// immediately before them.
+func DistinctUntilChangedBy[T any, T_Key comparable](prior Enumerable[T], keyFunc func(T) T_Key) Enumerable[T] {
+	return &enumerableDistinctUntilChanged[T, T_Key]{
+		Prior:   prior,
+		KeyFunc: keyFunc,
+	}
+}
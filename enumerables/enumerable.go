@@ -1,6 +1,10 @@
 package enumerables
 
-import "context"
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+)
 
 type actionDelegate[T any] struct {
 	Action        func()
@@ -12,6 +16,27 @@ type Enumerable[T any] interface {
 	getAction() *actionDelegate[T]
 }
 
+// sourceError records the error that ended reading from a fallible source.
+// Sources hand out its Err method as their companion error value. It is
+// written by the source goroutine and may be read concurrently by the
+// consumer, so access is guarded.
+type sourceError struct {
+	mu  sync.Mutex
+	err error
+}
+
+func (x *sourceError) setErr(err error) {
+	x.mu.Lock()
+	defer x.mu.Unlock()
+	x.err = err
+}
+
+func (x *sourceError) Err() error {
+	x.mu.Lock()
+	defer x.mu.Unlock()
+	return x.err
+}
+
 func newActionDelegate[T any]() (*actionDelegate[T], *context.Context) {
 	ctx, cancelFunc := context.WithCancel(context.Background())
 
@@ -33,9 +58,63 @@ func actionIsCancelled(ctx *context.Context) bool {
 	}
 }
 
+// sendResult blocks until item is received or the action is cancelled,
+// so that a cancelled action never stays parked on an abandoned channel.
+func sendResult[T any](ctx *context.Context, resultChannel chan T, item T) bool {
+	select {
+	case resultChannel <- item:
+		return true
+	case <-(*ctx).Done():
+		return false
+	}
+}
+
 func runAction[T any](src Enumerable[T]) (chan T, func()) {
 	actionDelegate := src.getAction()
 
 	go actionDelegate.Action()
 	return actionDelegate.ResultChannel, actionDelegate.CancelFunc
 }
+
+// drainAction runs action and collects everything it yields. It gives up,
+// cancelling action, if ctx is cancelled first.
+func drainAction[T any](ctx *context.Context, action *actionDelegate[T]) ([]T, bool) {
+	go action.Action()
+
+	result := []T{}
+	for x := range action.ResultChannel {
+		if actionIsCancelled(ctx) {
+			action.CancelFunc()
+			return nil, false
+		}
+		result = append(result, x)
+	}
+
+	return result, true
+}
+
+// releaseUnstarted makes cancelling delegate before its action has started
+// call each of release, so that priors created eagerly in getAction but
+// never started, such as the second input of a Union cut short while
+// reading the first, are still cancelled and can free their resources. If
+// the action is run after that, it only closes its channel.
+func releaseUnstarted[T any](delegate *actionDelegate[T], release ...func()) {
+	var started atomic.Bool
+	action, cancelFunc := delegate.Action, delegate.CancelFunc
+
+	delegate.Action = func() {
+		if started.Swap(true) {
+			close(delegate.ResultChannel)
+			return
+		}
+		action()
+	}
+	delegate.CancelFunc = func() {
+		cancelFunc()
+		if !started.Swap(true) {
+			for _, x := range release {
+				x()
+			}
+		}
+	}
+}
@@ -1,6 +1,11 @@
 package enumerables
 
-import cmn "github.com/alexmacinnes/golinq/common"
+import (
+	"context"
+	"sync/atomic"
+
+	cmn "github.com/alexmacinnes/golinq/common"
+)
 
 type enumerableFromSlice[T any] struct {
 	Input *[]T
@@ -18,6 +23,12 @@ type ptrEnumerableFromMap[T_Key comparable, T_Value any] struct {
 	Input *map[T_Key]T_Value
 }
 
+type enumerableFromChan[T any] struct {
+	Input    <-chan T
+	Ctx      context.Context
+	consumed atomic.Bool
+}
+
 func (this *enumerableFromSlice[T_Out]) getAction() *actionDelegate[T_Out] {
 	actionDelegate, ctx := newActionDelegate[T_Out]()
 
@@ -25,10 +36,9 @@ func (this *enumerableFromSlice[T_Out]) getAction() *actionDelegate[T_Out] {
 		defer close(actionDelegate.ResultChannel)
 
 		for _, x := range *this.Input {
-			if actionIsCancelled(ctx) {
+			if !sendResult(ctx, actionDelegate.ResultChannel, x) {
 				break // abort the current operation
 			}
-			actionDelegate.ResultChannel <- x
 		}
 	}
 	actionDelegate.Action = action
@@ -42,11 +52,11 @@ func (this *ptrEnumerableFromSlice[T_Out]) getAction() *actionDelegate[*T_Out] {
 	action := func() {
 		defer close(actionDelegate.ResultChannel)
 
-		for _, x := range *this.Input {
-			if actionIsCancelled(ctx) {
+		for i := range *this.Input {
+			// address the element itself, not a copy held by the loop
+			if !sendResult(ctx, actionDelegate.ResultChannel, &(*this.Input)[i]) {
 				break // abort the current operation
 			}
-			actionDelegate.ResultChannel <- &x
 		}
 	}
 	actionDelegate.Action = action
@@ -61,14 +71,13 @@ func (this *enumerableFromMap[T_Key, T_Value]) getAction() *actionDelegate[cmn.K
 		defer close(actionDelegate.ResultChannel)
 
 		for k, v := range *this.Input {
-			if actionIsCancelled(ctx) {
-				break // abort the current operation
-			}
 			kvp := cmn.KeyValuePair[T_Key, T_Value]{
 				Key:   k,
 				Value: v,
 			}
-			actionDelegate.ResultChannel <- kvp
+			if !sendResult(ctx, actionDelegate.ResultChannel, kvp) {
+				break // abort the current operation
+			}
 		}
 	}
 	actionDelegate.Action = action
@@ -83,14 +92,45 @@ func (this *ptrEnumerableFromMap[T_Key, T_Value]) getAction() *actionDelegate[cm
 		defer close(actionDelegate.ResultChannel)
 
 		for k, v := range *this.Input {
-			if actionIsCancelled(ctx) {
-				break // abort the current operation
-			}
+			value := v // each pair gets its own copy
 			kvp := cmn.KeyValuePair[T_Key, *T_Value]{
 				Key:   k,
-				Value: &v,
+				Value: &value,
+			}
+			if !sendResult(ctx, actionDelegate.ResultChannel, kvp) {
+				break // abort the current operation
+			}
+		}
+	}
+	actionDelegate.Action = action
+
+	return actionDelegate
+}
+
+func (this *enumerableFromChan[T]) getAction() *actionDelegate[T] {
+	if this.consumed.Swap(true) {
+		panic(cmn.ErrAlreadyEnumerated)
+	}
+
+	actionDelegate, ctx := newActionDelegate[T]()
+
+	action := func() {
+		defer close(actionDelegate.ResultChannel)
+
+		for {
+			select {
+			case x, ok := <-this.Input:
+				if !ok {
+					return
+				}
+				if !sendResult(ctx, actionDelegate.ResultChannel, x) {
+					return // abort the current operation
+				}
+			case <-(*ctx).Done():
+				return // abort the current operation
+			case <-this.Ctx.Done():
+				return
 			}
-			actionDelegate.ResultChannel <- kvp
 		}
 	}
 	actionDelegate.Action = action
@@ -110,18 +150,36 @@ func FromMap[T_Key comparable, T_Value any](input *map[T_Key]T_Value) Enumerable
 	return &enumerableFromMap[T_Key, T_Value]{Input: input}
 }
 
+// PointersFromMap yields a pointer to a copy of each value, as map values
+// cannot be addressed. Use UpdateValues to change the values held in the map.
 func PointersFromMap[T_Key comparable, T_Value any](input *map[T_Key]T_Value) Enumerable[cmn.KeyValuePair[T_Key, *T_Value]] {
-	//TODO - this does work consistently
-	// it's probably wrong to address map vals
-	// is this even useful?
+	return &ptrEnumerableFromMap[T_Key, T_Value]{Input: input}
+}
 
-	x := FromMap(input)
-	res := Select(x, func(kvp cmn.KeyValuePair[T_Key, T_Value]) cmn.KeyValuePair[T_Key, *T_Value] {
-		return cmn.KeyValuePair[T_Key, *T_Value]{Key: kvp.Key, Value: &kvp.Value}
-	})
+// UpdateValues applies mutate to every value of input whose entry matches
+// predicate, writing each result back into the map. It returns the number
+// of values updated.
+func UpdateValues[T_Key comparable, T_Value any](input *map[T_Key]T_Value, predicate func(cmn.KeyValuePair[T_Key, T_Value]) bool, mutate func(*T_Value)) int {
+	// collect matches first, as the map cannot be written while it is being read
+	matches := ToSlice(Where(FromMap(input), predicate))
+
+	for _, kvp := range matches {
+		value := kvp.Value
+		mutate(&value)
+		(*input)[kvp.Key] = value
+	}
 
-	return res
+	return len(matches)
+}
+
+// FromChannel reads items from input until it is closed. A channel can only be
+// drained once, so the result is single-use: enumerating it a second time
+// panics with cmn.ErrAlreadyEnumerated.
+func FromChannel[T any](input <-chan T) Enumerable[T] {
+	return FromChannelContext(context.Background(), input)
+}
 
-	// intermittent failures
-	//return &ptrEnumerableFromMap[T_Key, T_Value]{Input: input}
+// FromChannelContext is FromChannel, but also stops when ctx is done.
+func FromChannelContext[T any](ctx context.Context, input <-chan T) Enumerable[T] {
+	return &enumerableFromChan[T]{Input: input, Ctx: ctx}
 }
@@ -0,0 +1,101 @@
+package enumerables
+
+import (
+	"io/fs"
+	"path"
+
+	cmn "github.com/alexmacinnes/golinq/common"
+)
+
+type enumerableFromFS struct {
+	sourceError
+	FS      fs.FS
+	Root    string
+	Options cmn.FSOptions
+}
+
+func (this *enumerableFromFS) getAction() *actionDelegate[cmn.FSEntry] {
+	actionDelegate, ctx := newActionDelegate[cmn.FSEntry]()
+
+	action := func() {
+		defer close(actionDelegate.ResultChannel)
+
+		this.setErr(nil)
+
+		_, err := path.Match(this.Options.Pattern, "")
+		if err != nil {
+			this.setErr(err)
+			return
+		}
+
+		info, err := fs.Stat(this.FS, this.Root)
+		if err != nil {
+			this.setErr(err)
+			return
+		}
+
+		pending := []cmn.FSEntry{
+			{Path: this.Root, Entry: fs.FileInfoToDirEntry(info), Depth: 0},
+		}
+
+		for len(pending) > 0 {
+			if actionIsCancelled(ctx) {
+				return // abort the current operation
+			}
+
+			next := pending[len(pending)-1]
+			pending = pending[:len(pending)-1]
+
+			match := true
+			if this.Options.Pattern != "" {
+				match, _ = path.Match(this.Options.Pattern, next.Entry.Name())
+			}
+			if match && !sendResult(ctx, actionDelegate.ResultChannel, next) {
+				return // abort the current operation
+			}
+
+			// a directory is only read once it has been yielded and the
+			// walk moves on, so that SkipDir can depend on what the
+			// consumer has seen
+			if next.Entry.IsDir() &&
+				(this.Options.MaxDepth <= 0 || next.Depth < this.Options.MaxDepth) &&
+				(this.Options.SkipDir == nil || !this.Options.SkipDir(next)) {
+
+				if actionIsCancelled(ctx) {
+					return // abort the current operation
+				}
+
+				children, err := fs.ReadDir(this.FS, next.Path)
+				if err != nil {
+					this.setErr(err)
+					return
+				}
+
+				// push in reverse so that children are popped in lexical order
+				for i := len(children) - 1; i >= 0; i-- {
+					pending = append(pending, cmn.FSEntry{
+						Path:  path.Join(next.Path, children[i].Name()),
+						Entry: children[i],
+						Depth: next.Depth + 1,
+					})
+				}
+			}
+		}
+	}
+	actionDelegate.Action = action
+
+	return actionDelegate
+}
+
+// FromFS lazily walks the tree at root in lexical order, yielding root
+// itself first, as fs.WalkDir does. Directories are only read once the
+// walk reaches them. The walk stops at the first error, which is then
+// reported by the returned error func.
+func FromFS(fsys fs.FS, root string) (Enumerable[cmn.FSEntry], func() error) {
+	return FromFSWithOptions(fsys, root, cmn.FSOptions{})
+}
+
+func FromFSWithOptions(fsys fs.FS, root string, options cmn.FSOptions) (Enumerable[cmn.FSEntry], func() error) {
+	result := &enumerableFromFS{FS: fsys, Root: root, Options: options}
+	return result, result.Err
+}
@@ -0,0 +1,121 @@
+package enumerables
+
+type enumerableRange struct {
+	Start int
+	Count int
+}
+
+type enumerableRepeat[T any] struct {
+	Value T
+	Count int
+}
+
+type enumerableGenerate[T any] struct {
+	Seed     T
+	NextFunc func(T) T
+}
+
+type enumerableUnfold[T any, S any] struct {
+	Seed      S
+	Generator func(S) (T, S, bool)
+}
+
+func (this *enumerableRange) getAction() *actionDelegate[int] {
+	actionDelegate, ctx := newActionDelegate[int]()
+
+	action := func() {
+		defer close(actionDelegate.ResultChannel)
+
+		for i := 0; i < this.Count; i++ {
+			if !sendResult(ctx, actionDelegate.ResultChannel, this.Start+i) {
+				break // abort the current operation
+			}
+		}
+	}
+	actionDelegate.Action = action
+
+	return actionDelegate
+}
+
+func (this *enumerableRepeat[T]) getAction() *actionDelegate[T] {
+	actionDelegate, ctx := newActionDelegate[T]()
+
+	action := func() {
+		defer close(actionDelegate.ResultChannel)
+
+		for i := 0; i < this.Count; i++ {
+			if !sendResult(ctx, actionDelegate.ResultChannel, this.Value) {
+				break // abort the current operation
+			}
+		}
+	}
+	actionDelegate.Action = action
+
+	return actionDelegate
+}
+
+func (this *enumerableGenerate[T]) getAction() *actionDelegate[T] {
+	actionDelegate, ctx := newActionDelegate[T]()
+
+	action := func() {
+		defer close(actionDelegate.ResultChannel)
+
+		current := this.Seed
+		for {
+			if !sendResult(ctx, actionDelegate.ResultChannel, current) {
+				break // abort the current operation
+			}
+			current = this.NextFunc(current)
+		}
+	}
+	actionDelegate.Action = action
+
+	return actionDelegate
+}
+
+func (this *enumerableUnfold[T, S]) getAction() *actionDelegate[T] {
+	actionDelegate, ctx := newActionDelegate[T]()
+
+	action := func() {
+		defer close(actionDelegate.ResultChannel)
+
+		state := this.Seed
+		for {
+			if actionIsCancelled(ctx) {
+				break // abort the current operation
+			}
+			result, next, ok := this.Generator(state)
+			if !ok {
+				break
+			}
+			if !sendResult(ctx, actionDelegate.ResultChannel, result) {
+				break // abort the current operation
+			}
+			state = next
+		}
+	}
+	actionDelegate.Action = action
+
+	return actionDelegate
+}
+
+func Range(start int, count int) Enumerable[int] {
+	return &enumerableRange{Start: start, Count: count}
+}
+
+func Repeat[T any](value T, count int) Enumerable[T] {
+	return &enumerableRepeat[T]{Value: value, Count: count}
+}
+
+// Generate yields seed, next(seed), next(next(seed)), ... without end.
+// Bound it with an early-terminating operation such as First or ElementAt,
+// which cancels the generating goroutine.
+func Generate[T any](seed T, next func(T) T) Enumerable[T] {
+	return &enumerableGenerate[T]{Seed: seed, NextFunc: next}
+}
+
+// Unfold calls generator with the current state, yielding its item and
+// carrying its new state forward, until generator returns false.
+func Unfold[T any, S any](seed S, generator func(S) (T, S, bool)) Enumerable[T] {
+	return &enumerableUnfold[T, S]{Seed: seed, Generator: generator}
+}
@@ -0,0 +1,75 @@
+package enumerables
+
+import cmn "github.com/alexmacinnes/golinq/common"
+
+type enumerableGroupBy[T_In any, T_Key comparable, T_Element any] struct {
+	Prior       Enumerable[T_In]
+	KeyFunc     func(T_In) T_Key
+	ElementFunc func(T_In) T_Element
+}
+
+func (this *enumerableGroupBy[T_In, T_Key, T_Element]) getAction() *actionDelegate[cmn.Grouping[T_Key, T_Element]] {
+	actionDelegate, ctx := newActionDelegate[cmn.Grouping[T_Key, T_Element]]()
+	priorAction := this.Prior.getAction()
+
+	action := func() {
+		defer close(actionDelegate.ResultChannel)
+
+		items, ok := drainAction(ctx, priorAction)
+		if !ok {
+			return
+		}
+
+		groups := []cmn.Grouping[T_Key, T_Element]{}
+		positions := make(map[T_Key]int)
+		for _, x := range items {
+			key := this.KeyFunc(x)
+			i, exists := positions[key]
+			if !exists {
+				i = len(groups)
+				positions[key] = i
+				groups = append(groups, cmn.Grouping[T_Key, T_Element]{Key: key})
+			}
+			groups[i].Items = append(groups[i].Items, this.ElementFunc(x))
+		}
+
+		for _, g := range groups {
+			if !sendResult(ctx, actionDelegate.ResultChannel, g) {
+				break
+			}
+		}
+	}
+	actionDelegate.Action = action
+	releaseUnstarted(actionDelegate, priorAction.CancelFunc)
+
+	return actionDelegate
+}
+
+// GroupBy reads all of prior, then yields one Grouping per key in the order
+// each key was first seen. Query a group's items with FromGrouping.
+func GroupBy[T any, T_Key comparable](prior Enumerable[T], keyFunc func(T) T_Key) Enumerable[cmn.Grouping[T_Key, T]] {
+	return GroupByElement(prior, keyFunc, func(x T) T { return x })
+}
+
+// GroupByElement is GroupBy, collecting elementFunc of each item rather than
+// the item itself.
+func GroupByElement[T_In any, T_Key comparable, T_Element any](prior Enumerable[T_In], keyFunc func(T_In) T_Key, elementFunc func(T_In) T_Element) Enumerable[cmn.Grouping[T_Key, T_Element]] {
+	return &enumerableGroupBy[T_In, T_Key, T_Element]{
+		Prior:       prior,
+		KeyFunc:     keyFunc,
+		ElementFunc: elementFunc,
+	}
+}
+
+// GroupByResult is GroupByElement, reducing each group to a single value
+// through result.
+func GroupByResult[T_In any, T_Key comparable, T_Element any, T_Out any](prior Enumerable[T_In], keyFunc func(T_In) T_Key, elementFunc func(T_In) T_Element, result func(T_Key, Enumerable[T_Element]) T_Out) Enumerable[T_Out] {
+	return Select(GroupByElement(prior, keyFunc, elementFunc), func(g cmn.Grouping[T_Key, T_Element]) T_Out {
+		return result(g.Key, FromGrouping(g))
+	})
+}
+
+// FromGrouping yields the items of a group produced by GroupBy.
+func FromGrouping[T_Key comparable, T_Value any](input cmn.Grouping[T_Key, T_Value]) Enumerable[T_Value] {
+	return FromSlice(&input.Items)
+}
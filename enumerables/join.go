@@ -0,0 +1,148 @@
+package enumerables
+
+// enumerableHashJoin reads Inner into a hash table before Outer starts, then
+// streams Outer, handing each outer item and its matches to Combine. When
+// Unmatched is set, the inner items no outer item matched follow at the end.
+type enumerableHashJoin[T_Outer any, T_Inner any, T_Key comparable, T_Out any] struct {
+	Outer     Enumerable[T_Outer]
+	Inner     Enumerable[T_Inner]
+	OuterKey  func(T_Outer) T_Key
+	InnerKey  func(T_Inner) T_Key
+	Combine   func(T_Outer, []T_Inner) []T_Out
+	Unmatched func(T_Inner) T_Out
+}
+
+func (this *enumerableHashJoin[T_Outer, T_Inner, T_Key, T_Out]) getAction() *actionDelegate[T_Out] {
+	actionDelegate, ctx := newActionDelegate[T_Out]()
+	outerAction := this.Outer.getAction()
+	innerAction := this.Inner.getAction()
+
+	action := func() {
+		defer close(actionDelegate.ResultChannel)
+
+		innerItems, ok := drainAction(ctx, innerAction)
+		if !ok {
+			outerAction.CancelFunc() // cancel the outer operation
+			return
+		}
+		lookup := make(map[T_Key][]T_Inner)
+		for _, x := range innerItems {
+			key := this.InnerKey(x)
+			lookup[key] = append(lookup[key], x)
+		}
+		matched := make(map[T_Key]bool)
+
+		go outerAction.Action()
+		chanIn := outerAction.ResultChannel
+
+		for x := range chanIn {
+			if actionIsCancelled(ctx) {
+				outerAction.CancelFunc() // cancel the outer operation
+				return
+			}
+
+			key := this.OuterKey(x)
+			matched[key] = true
+			for _, result := range this.Combine(x, lookup[key]) {
+				if !sendResult(ctx, actionDelegate.ResultChannel, result) {
+					outerAction.CancelFunc() // cancel the outer operation
+					return
+				}
+			}
+		}
+
+		if this.Unmatched == nil {
+			return
+		}
+		for _, x := range innerItems {
+			if !matched[this.InnerKey(x)] {
+				if !sendResult(ctx, actionDelegate.ResultChannel, this.Unmatched(x)) {
+					return
+				}
+			}
+		}
+	}
+	actionDelegate.Action = action
+	releaseUnstarted(actionDelegate, outerAction.CancelFunc, innerAction.CancelFunc)
+
+	return actionDelegate
+}
+
+// Join pairs each item of outer with every item of inner that has the same
+// key, in outer order. Outer items with no match are dropped.
+func Join[T_Outer any, T_Inner any, T_Key comparable, T_Out any](outer Enumerable[T_Outer], inner Enumerable[T_Inner], outerKey func(T_Outer) T_Key, innerKey func(T_Inner) T_Key, result func(T_Outer, T_Inner) T_Out) Enumerable[T_Out] {
+	return &enumerableHashJoin[T_Outer, T_Inner, T_Key, T_Out]{
+		Outer:    outer,
+		Inner:    inner,
+		OuterKey: outerKey,
+		InnerKey: innerKey,
+		Combine: func(o T_Outer, matches []T_Inner) []T_Out {
+			results := make([]T_Out, len(matches))
+			for i, m := range matches {
+				results[i] = result(o, m)
+			}
+			return results
+		},
+	}
+}
+
+// GroupJoin passes each item of outer to result together with all of its
+// matching inner items, which may be none.
+func GroupJoin[T_Outer any, T_Inner any, T_Key comparable, T_Out any](outer Enumerable[T_Outer], inner Enumerable[T_Inner], outerKey func(T_Outer) T_Key, innerKey func(T_Inner) T_Key, result func(T_Outer, Enumerable[T_Inner]) T_Out) Enumerable[T_Out] {
+	return &enumerableHashJoin[T_Outer, T_Inner, T_Key, T_Out]{
+		Outer:    outer,
+		Inner:    inner,
+		OuterKey: outerKey,
+		InnerKey: innerKey,
+		Combine: func(o T_Outer, matches []T_Inner) []T_Out {
+			return []T_Out{result(o, FromSlice(&matches))}
+		},
+	}
+}
+
+// LeftJoin is Join, but an outer item with no match is still passed to
+// result once, with a nil inner item.
+func LeftJoin[T_Outer any, T_Inner any, T_Key comparable, T_Out any](outer Enumerable[T_Outer], inner Enumerable[T_Inner], outerKey func(T_Outer) T_Key, innerKey func(T_Inner) T_Key, result func(T_Outer, *T_Inner) T_Out) Enumerable[T_Out] {
+	return &enumerableHashJoin[T_Outer, T_Inner, T_Key, T_Out]{
+		Outer:    outer,
+		Inner:    inner,
+		OuterKey: outerKey,
+		InnerKey: innerKey,
+		Combine: func(o T_Outer, matches []T_Inner) []T_Out {
+			if len(matches) == 0 {
+				return []T_Out{result(o, nil)}
+			}
+			results := make([]T_Out, len(matches))
+			for i, m := range matches {
+				m := m
+				results[i] = result(o, &m)
+			}
+			return results
+		},
+	}
+}
+
+// FullOuterJoin is LeftJoin, followed by the inner items that matched no
+// outer item, each passed to result with a nil outer item.
+func FullOuterJoin[T_Outer any, T_Inner any, T_Key comparable, T_Out any](outer Enumerable[T_Outer], inner Enumerable[T_Inner], outerKey func(T_Outer) T_Key, innerKey func(T_Inner) T_Key, result func(*T_Outer, *T_Inner) T_Out) Enumerable[T_Out] {
+	return &enumerableHashJoin[T_Outer, T_Inner, T_Key, T_Out]{
+		Outer:    outer,
+		Inner:    inner,
+		OuterKey: outerKey,
+		InnerKey: innerKey,
+		Combine: func(o T_Outer, matches []T_Inner) []T_Out {
+			if len(matches) == 0 {
+				return []T_Out{result(&o, nil)}
+			}
+			results := make([]T_Out, len(matches))
+			for i, m := range matches {
+				m := m
+				results[i] = result(&o, &m)
+			}
+			return results
+		},
+		Unmatched: func(i T_Inner) T_Out {
+			return result(nil, &i)
+		},
+	}
+}
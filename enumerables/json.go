@@ -0,0 +1,105 @@
+package enumerables
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"sync/atomic"
+
+	cmn "github.com/alexmacinnes/golinq/common"
+)
+
+type enumerableFromJSON[T any] struct {
+	sourceError
+	Reader   io.Reader
+	IsArray  bool
+	consumed atomic.Bool
+}
+
+func (this *enumerableFromJSON[T]) getAction() *actionDelegate[T] {
+	if this.consumed.Swap(true) {
+		panic(cmn.ErrAlreadyEnumerated)
+	}
+
+	actionDelegate, ctx := newActionDelegate[T]()
+
+	action := func() {
+		defer close(actionDelegate.ResultChannel)
+
+		decoder := json.NewDecoder(this.Reader)
+		index := 0
+		offset := decoder.InputOffset()
+
+		fail := func(err error) {
+			if err == io.EOF {
+				err = io.ErrUnexpectedEOF
+			}
+			var syntaxErr *json.SyntaxError
+			if errors.As(err, &syntaxErr) {
+				offset = syntaxErr.Offset
+			}
+			this.setErr(&cmn.DecodeError{Index: index, Offset: offset, Err: err})
+		}
+
+		if this.IsArray {
+			token, err := decoder.Token()
+			if err == nil && token != json.Delim('[') {
+				err = fmt.Errorf("expected start of array, found %v", token)
+			}
+			if err != nil {
+				fail(err)
+				return
+			}
+		}
+
+		for {
+			if actionIsCancelled(ctx) {
+				return // abort the current operation
+			}
+
+			offset = decoder.InputOffset()
+			if this.IsArray && !decoder.More() {
+				_, err := decoder.Token() // closing bracket
+				if err != nil {
+					fail(err)
+				}
+				return
+			}
+
+			var result T
+			err := decoder.Decode(&result)
+			if err == io.EOF && !this.IsArray {
+				return
+			}
+			if err != nil {
+				fail(err)
+				return
+			}
+
+			if !sendResult(ctx, actionDelegate.ResultChannel, result) {
+				return // abort the current operation
+			}
+			index++
+		}
+	}
+	actionDelegate.Action = action
+
+	return actionDelegate
+}
+
+// FromJSONArray decodes the elements of a JSON array one at a time, so the
+// whole document is never held in memory. Decoding stops at the first bad
+// element, and the returned error func then reports a *cmn.DecodeError.
+// The reader can only be read once, so the result is single-use.
+func FromJSONArray[T any](reader io.Reader) (Enumerable[T], func() error) {
+	result := &enumerableFromJSON[T]{Reader: reader, IsArray: true}
+	return result, result.Err
+}
+
+// FromNDJSON decodes a stream of newline-delimited JSON values one at a time.
+// Errors are reported as for FromJSONArray.
+func FromNDJSON[T any](reader io.Reader) (Enumerable[T], func() error) {
+	result := &enumerableFromJSON[T]{Reader: reader}
+	return result, result.Err
+}
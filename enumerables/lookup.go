@@ -0,0 +1,47 @@
+package enumerables
+
+// Lookup is a read-only, one-to-many index built by ToLookup. Keys keep
+// the order in which they were first seen.
+type Lookup[T_Key comparable, T_Value any] struct {
+	keys   []T_Key
+	values map[T_Key][]T_Value
+}
+
+// Get yields the values stored under key, which is empty for a missing key.
+func (x *Lookup[T_Key, T_Value]) Get(key T_Key) Enumerable[T_Value] {
+	values := x.values[key]
+	return FromSlice(&values)
+}
+
+func (x *Lookup[T_Key, T_Value]) Contains(key T_Key) bool {
+	_, exists := x.values[key]
+	return exists
+}
+
+// Count is the number of distinct keys.
+func (x *Lookup[T_Key, T_Value]) Count() int {
+	return len(x.keys)
+}
+
+func (x *Lookup[T_Key, T_Value]) Keys() Enumerable[T_Key] {
+	return FromSlice(&x.keys)
+}
+
+func ToLookup[T_In any, T_OutKey comparable, T_OutValue any](src Enumerable[T_In], keyFunc func(T_In) T_OutKey, valueFunc func(T_In) T_OutValue) *Lookup[T_OutKey, T_OutValue] {
+	resultChannel, _ := runAction(src)
+
+	result := &Lookup[T_OutKey, T_OutValue]{
+		values: map[T_OutKey][]T_OutValue{},
+	}
+
+	for x := range resultChannel {
+		key := keyFunc(x)
+		values, exists := result.values[key]
+		if !exists {
+			result.keys = append(result.keys, key)
+		}
+		result.values[key] = append(values, valueFunc(x))
+	}
+
+	return result
+}
@@ -0,0 +1,173 @@
+package enumerables
+
+import (
+	"sort"
+
+	cmn "github.com/alexmacinnes/golinq/common"
+)
+
+// OrderedEnumerable is the Enumerable returned by OrderBy and ThenBy.
+// OrderBy returns it as a plain Enumerable so that it chains into other
+// operators; ThenBy and First recover it with a type assertion.
+type OrderedEnumerable[T any] interface {
+	Enumerable[T]
+	thenBy(key orderKey[T]) Enumerable[T]
+	first() (T, bool)
+}
+
+// orderKey is one level of an ordering. Given the items to order, it
+// returns a less function over their positions, computing any keys it
+// needs once up front.
+type orderKey[T any] func(items []T) func(i, j int) bool
+
+func keyOrder[T any, T_Key cmn.Ordered](keyFunc func(T) T_Key, descending bool) orderKey[T] {
+	return func(items []T) func(i, j int) bool {
+		keys := make([]T_Key, len(items))
+		for i, x := range items {
+			keys[i] = keyFunc(x)
+		}
+
+		if descending {
+			return func(i, j int) bool { return keys[j] < keys[i] }
+		}
+		return func(i, j int) bool { return keys[i] < keys[j] }
+	}
+}
+
+func funcOrder[T any](less func(T, T) bool) orderKey[T] {
+	return func(items []T) func(i, j int) bool {
+		return func(i, j int) bool { return less(items[i], items[j]) }
+	}
+}
+
+type enumerableOrdered[T any] struct {
+	Prior Enumerable[T]
+	Keys  []orderKey[T]
+}
+
+// less orders positions in items by the first key that tells them apart.
+func (this *enumerableOrdered[T]) less(items []T) func(i, j int) bool {
+	levels := make([]func(i, j int) bool, len(this.Keys))
+	for n, key := range this.Keys {
+		levels[n] = key(items)
+	}
+
+	return func(i, j int) bool {
+		for _, less := range levels {
+			if less(i, j) {
+				return true
+			}
+			if less(j, i) {
+				return false
+			}
+		}
+		return false
+	}
+}
+
+func (this *enumerableOrdered[T]) getAction() *actionDelegate[T] {
+	actionDelegate, ctx := newActionDelegate[T]()
+	priorAction := this.Prior.getAction()
+
+	action := func() {
+		defer close(actionDelegate.ResultChannel)
+
+		items, ok := drainAction(ctx, priorAction)
+		if !ok {
+			return
+		}
+
+		less := this.less(items)
+		positions := make([]int, len(items))
+		for i := range positions {
+			positions[i] = i
+		}
+		sort.SliceStable(positions, func(a, b int) bool { return less(positions[a], positions[b]) })
+
+		for _, position := range positions {
+			if !sendResult(ctx, actionDelegate.ResultChannel, items[position]) {
+				break
+			}
+		}
+	}
+	actionDelegate.Action = action
+	releaseUnstarted(actionDelegate, priorAction.CancelFunc)
+
+	return actionDelegate
+}
+
+func (this *enumerableOrdered[T]) thenBy(key orderKey[T]) Enumerable[T] {
+	keys := make([]orderKey[T], len(this.Keys), len(this.Keys)+1)
+	copy(keys, this.Keys)
+
+	return &enumerableOrdered[T]{
+		Prior: this.Prior,
+		Keys:  append(keys, key),
+	}
+}
+
+// first finds the item a full sort would put first, in a single pass.
+func (this *enumerableOrdered[T]) first() (T, bool) {
+	items := ToSlice(this.Prior)
+	if len(items) == 0 {
+		var none T
+		return none, false
+	}
+
+	less := this.less(items)
+	best := 0
+	for i := 1; i < len(items); i++ {
+		if less(i, best) {
+			best = i
+		}
+	}
+	return items[best], true
+}
+
+// OrderBy sorts prior into ascending key order. The sort is stable, computes
+// each item's key once, and does not start until the result is first read.
+func OrderBy[T any, T_Key cmn.Ordered](prior Enumerable[T], keyFunc func(T) T_Key) Enumerable[T] {
+	return &enumerableOrdered[T]{
+		Prior: prior,
+		Keys:  []orderKey[T]{keyOrder(keyFunc, false)},
+	}
+}
+
+func OrderByDescending[T any, T_Key cmn.Ordered](prior Enumerable[T], keyFunc func(T) T_Key) Enumerable[T] {
+	return &enumerableOrdered[T]{
+		Prior: prior,
+		Keys:  []orderKey[T]{keyOrder(keyFunc, true)},
+	}
+}
+
+// OrderByFunc is OrderBy, ordering items by less.
+func OrderByFunc[T any](prior Enumerable[T], less func(T, T) bool) Enumerable[T] {
+	return &enumerableOrdered[T]{
+		Prior: prior,
+		Keys:  []orderKey[T]{funcOrder(less)},
+	}
+}
+
+// ThenBy orders the items that prior's existing keys consider equal by an
+// ascending secondary key. Prior must have come from OrderBy or ThenBy,
+// otherwise ThenBy panics with cmn.ErrNotOrdered.
+func ThenBy[T any, T_Key cmn.Ordered](prior Enumerable[T], keyFunc func(T) T_Key) Enumerable[T] {
+	return appendOrder(prior, keyOrder(keyFunc, false))
+}
+
+func ThenByDescending[T any, T_Key cmn.Ordered](prior Enumerable[T], keyFunc func(T) T_Key) Enumerable[T] {
+	return appendOrder(prior, keyOrder(keyFunc, true))
+}
+
+// ThenByFunc is ThenBy, ordering items by less.
+func ThenByFunc[T any](prior Enumerable[T], less func(T, T) bool) Enumerable[T] {
+	return appendOrder(prior, funcOrder(less))
+}
+
+func appendOrder[T any](prior Enumerable[T], key orderKey[T]) Enumerable[T] {
+	ordered, ok := prior.(OrderedEnumerable[T])
+	if !ok {
+		panic(cmn.ErrNotOrdered)
+	}
+	return ordered.thenBy(key)
+}
@@ -0,0 +1,66 @@
+package enumerables
+
+import (
+	"database/sql"
+	"sync/atomic"
+
+	cmn "github.com/alexmacinnes/golinq/common"
+)
+
+type enumerableFromRows[T any] struct {
+	sourceError
+	Rows     *sql.Rows
+	Scan     func(*sql.Rows) (T, error)
+	consumed atomic.Bool
+}
+
+func (this *enumerableFromRows[T]) getAction() *actionDelegate[T] {
+	if this.consumed.Swap(true) {
+		panic(cmn.ErrAlreadyEnumerated)
+	}
+
+	actionDelegate, ctx := newActionDelegate[T]()
+
+	action := func() {
+		defer close(actionDelegate.ResultChannel)
+
+		var err error
+		defer func() {
+			closeErr := this.Rows.Close()
+			if err == nil {
+				err = closeErr
+			}
+			this.setErr(err)
+		}()
+
+		for this.Rows.Next() {
+			if actionIsCancelled(ctx) {
+				return // abort the current operation
+			}
+
+			var result T
+			result, err = this.Scan(this.Rows)
+			if err != nil {
+				return
+			}
+			if !sendResult(ctx, actionDelegate.ResultChannel, result) {
+				return // abort the current operation
+			}
+		}
+		err = this.Rows.Err()
+	}
+	actionDelegate.Action = action
+	releaseUnstarted(actionDelegate, func() { this.setErr(this.Rows.Close()) })
+
+	return actionDelegate
+}
+
+// FromRows yields scan applied to each row of rows. The rows are closed when
+// enumeration ends, fails, or is cut short by an operation such as First.
+// Enumeration stops at the first error, which is then reported by the
+// returned error func. Rows can only be read once, so the result is
+// single-use.
+func FromRows[T any](rows *sql.Rows, scan func(*sql.Rows) (T, error)) (Enumerable[T], func() error) {
+	result := &enumerableFromRows[T]{Rows: rows, Scan: scan}
+	return result, result.Err
+}
@@ -0,0 +1,96 @@
+package enumerables
+
+type enumerableScan[TAccumulate any, TItem any] struct {
+	Prior       Enumerable[TItem]
+	Seed        TAccumulate
+	Accumulator func(TAccumulate, TItem) TAccumulate
+}
+
+func (this *enumerableScan[TAccumulate, TItem]) getAction() *actionDelegate[TAccumulate] {
+	actionDelegate, ctx := newActionDelegate[TAccumulate]()
+	priorAction := this.Prior.getAction()
+
+	action := func() {
+		defer close(actionDelegate.ResultChannel)
+
+		current := this.Seed
+
+		go priorAction.Action()
+		chanIn := priorAction.ResultChannel
+
+		for x := range chanIn {
+			if actionIsCancelled(ctx) {
+				priorAction.CancelFunc() // cancel the prior operation
+				break
+			}
+			current = this.Accumulator(current, x)
+			if !sendResult(ctx, actionDelegate.ResultChannel, current) {
+				priorAction.CancelFunc() // cancel the prior operation
+				break
+			}
+		}
+	}
+	actionDelegate.Action = action
+	releaseUnstarted(actionDelegate, priorAction.CancelFunc)
+
+	return actionDelegate
+}
+
+// Scan is a lazy Accumulate, yielding the accumulated value after each item.
+func Scan[TAccumulate any, TItem any](prior Enumerable[TItem], seed TAccumulate, accumulator func(TAccumulate, TItem) TAccumulate) Enumerable[TAccumulate] {
+	return &enumerableScan[TAccumulate, TItem]{
+		Prior:       prior,
+		Seed:        seed,
+		Accumulator: accumulator,
+	}
+}
+
+type enumerableScanNoSeed[T any] struct {
+	Prior       Enumerable[T]
+	Accumulator func(T, T) T
+}
+
+func (this *enumerableScanNoSeed[T]) getAction() *actionDelegate[T] {
+	actionDelegate, ctx := newActionDelegate[T]()
+	priorAction := this.Prior.getAction()
+
+	action := func() {
+		defer close(actionDelegate.ResultChannel)
+
+		var current T
+		started := false
+
+		go priorAction.Action()
+		chanIn := priorAction.ResultChannel
+
+		for x := range chanIn {
+			if actionIsCancelled(ctx) {
+				priorAction.CancelFunc() // cancel the prior operation
+				break
+			}
+			if started {
+				current = this.Accumulator(current, x)
+			} else {
+				started = true
+				current = x
+			}
+			if !sendResult(ctx, actionDelegate.ResultChannel, current) {
+				priorAction.CancelFunc() // cancel the prior operation
+				break
+			}
+		}
+	}
+	actionDelegate.Action = action
+	releaseUnstarted(actionDelegate, priorAction.CancelFunc)
+
+	return actionDelegate
+}
+
+// ScanNoSeed is Scan, using the first item as the seed. The first item is
+// yielded unchanged.
+func ScanNoSeed[T any](prior Enumerable[T], accumulator func(T, T) T) Enumerable[T] {
+	return &enumerableScanNoSeed[T]{
+		Prior:       prior,
+		Accumulator: accumulator,
+	}
+}
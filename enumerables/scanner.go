@@ -0,0 +1,56 @@
+package enumerables
+
+import (
+	"bufio"
+	"io"
+	"sync/atomic"
+
+	cmn "github.com/alexmacinnes/golinq/common"
+)
+
+type enumerableFromScanner struct {
+	sourceError
+	Scanner  *bufio.Scanner
+	consumed atomic.Bool
+}
+
+func (this *enumerableFromScanner) getAction() *actionDelegate[string] {
+	if this.consumed.Swap(true) {
+		panic(cmn.ErrAlreadyEnumerated)
+	}
+
+	actionDelegate, ctx := newActionDelegate[string]()
+
+	action := func() {
+		defer close(actionDelegate.ResultChannel)
+
+		for {
+			if actionIsCancelled(ctx) {
+				return // abort the current operation
+			}
+			if !this.Scanner.Scan() {
+				this.setErr(this.Scanner.Err())
+				return
+			}
+			if !sendResult(ctx, actionDelegate.ResultChannel, this.Scanner.Text()) {
+				return // abort the current operation
+			}
+		}
+	}
+	actionDelegate.Action = action
+
+	return actionDelegate
+}
+
+// FromScanner yields each token produced by scanner. Reading stops at the
+// first scanner error, which is then reported by the returned error func.
+// The scanner can only be read once, so the result is single-use.
+func FromScanner(scanner *bufio.Scanner) (Enumerable[string], func() error) {
+	result := &enumerableFromScanner{Scanner: scanner}
+	return result, result.Err
+}
+
+// FromLines yields each line of reader, without its line ending.
+func FromLines(reader io.Reader) (Enumerable[string], func() error) {
+	return FromScanner(bufio.NewScanner(reader))
+}
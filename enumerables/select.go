@@ -1,5 +1,7 @@
 package enumerables
 
+import cmn "github.com/alexmacinnes/golinq/common"
+
 type enumerableSelect[T_In any, T_Out any] struct {
 	Prior    Enumerable[T_In]
 	Selector func(T_In) T_Out
@@ -7,12 +9,11 @@ type enumerableSelect[T_In any, T_Out any] struct {
 
 func (this *enumerableSelect[T_In, T_Out]) getAction() *actionDelegate[T_Out] {
 	actionDelegate, ctx := newActionDelegate[T_Out]()
+	priorAction := this.Prior.getAction()
 
 	action := func() {
 		defer close(actionDelegate.ResultChannel)
 
-		priorAction := this.Prior.getAction()
-
 		go priorAction.Action()
 		chanIn := priorAction.ResultChannel
 
@@ -22,10 +23,14 @@ func (this *enumerableSelect[T_In, T_Out]) getAction() *actionDelegate[T_Out] {
 				break
 			}
 			converted := this.Selector(x)
-			actionDelegate.ResultChannel <- converted
+			if !sendResult(ctx, actionDelegate.ResultChannel, converted) {
+				priorAction.CancelFunc() // cancel the prior operation
+				break
+			}
 		}
 	}
 	actionDelegate.Action = action
+	releaseUnstarted(actionDelegate, priorAction.CancelFunc)
 
 	return actionDelegate
 }
@@ -36,3 +41,53 @@ func Select[T_In any, T_Out any](prior Enumerable[T_In], selector func(T_In) T_O
 		Selector: selector,
 	}
 }
+
+type enumerableSelectIndexed[T_In any, T_Out any] struct {
+	Prior    Enumerable[T_In]
+	Selector func(T_In, int) T_Out
+}
+
+func (this *enumerableSelectIndexed[T_In, T_Out]) getAction() *actionDelegate[T_Out] {
+	actionDelegate, ctx := newActionDelegate[T_Out]()
+	priorAction := this.Prior.getAction()
+
+	action := func() {
+		defer close(actionDelegate.ResultChannel)
+
+		go priorAction.Action()
+		chanIn := priorAction.ResultChannel
+
+		index := 0
+		for x := range chanIn {
+			if actionIsCancelled(ctx) {
+				priorAction.CancelFunc() // cancel the prior operation
+				break
+			}
+			converted := this.Selector(x, index)
+			index++
+			if !sendResult(ctx, actionDelegate.ResultChannel, converted) {
+				priorAction.CancelFunc() // cancel the prior operation
+				break
+			}
+		}
+	}
+	actionDelegate.Action = action
+	releaseUnstarted(actionDelegate, priorAction.CancelFunc)
+
+	return actionDelegate
+}
+
+// SelectIndexed is Select, but also passes the position of each item,
+// counting from zero on every enumeration.
+func SelectIndexed[T_In any, T_Out any](prior Enumerable[T_In], selector func(T_In, int) T_Out) Enumerable[T_Out] {
+	return &enumerableSelectIndexed[T_In, T_Out]{
+		Prior:    prior,
+		Selector: selector,
+	}
+}
+
+func WithIndex[T any](prior Enumerable[T]) Enumerable[cmn.Indexed[T]] {
+	return SelectIndexed(prior, func(x T, i int) cmn.Indexed[T] {
+		return cmn.Indexed[T]{Index: i, Value: x}
+	})
+}
@@ -0,0 +1,76 @@
+package enumerables
+
+type enumerableSelectMany[T_In any, T_Inner any, T_Out any] struct {
+	Prior      Enumerable[T_In]
+	Collection func(T_In) Enumerable[T_Inner]
+	Result     func(T_In, T_Inner) T_Out
+}
+
+func (this *enumerableSelectMany[T_In, T_Inner, T_Out]) getAction() *actionDelegate[T_Out] {
+	actionDelegate, ctx := newActionDelegate[T_Out]()
+	priorAction := this.Prior.getAction()
+
+	action := func() {
+		defer close(actionDelegate.ResultChannel)
+
+		go priorAction.Action()
+		chanIn := priorAction.ResultChannel
+
+		for x := range chanIn {
+			if actionIsCancelled(ctx) {
+				priorAction.CancelFunc() // cancel the prior operation
+				return
+			}
+
+			innerAction := this.Collection(x).getAction()
+			go innerAction.Action()
+
+			for y := range innerAction.ResultChannel {
+				if !sendResult(ctx, actionDelegate.ResultChannel, this.Result(x, y)) {
+					innerAction.CancelFunc() // cancel the inner operation
+					priorAction.CancelFunc() // cancel the prior operation
+					return
+				}
+			}
+		}
+	}
+	actionDelegate.Action = action
+	releaseUnstarted(actionDelegate, priorAction.CancelFunc)
+
+	return actionDelegate
+}
+
+// SelectMany yields every item of the collection selector returns for each
+// item of prior. Collections are started on the goroutine running the
+// enumeration, so a single-use one, such as FromChannel, must not have been
+// enumerated already: its cmn.ErrAlreadyEnumerated panic could not be
+// recovered by the caller and would end the program. Have selector build a
+// fresh collection for each call instead.
+func SelectMany[T_In any, T_Out any](prior Enumerable[T_In], selector func(T_In) Enumerable[T_Out]) Enumerable[T_Out] {
+	return SelectManyResult(prior, selector, func(_ T_In, x T_Out) T_Out { return x })
+}
+
+func SelectManySlice[T_In any, T_Out any](prior Enumerable[T_In], selector func(T_In) []T_Out) Enumerable[T_Out] {
+	return SelectMany(prior, func(x T_In) Enumerable[T_Out] {
+		slice := selector(x)
+		return FromSlice(&slice)
+	})
+}
+
+// SelectManyResult expands each item into a collection, then pairs the item
+// with each member of its collection through result. Cancellation stops
+// both the prior and the collection currently being read. Collections are
+// subject to the same single-use restriction as for SelectMany.
+func SelectManyResult[T_In any, T_Inner any, T_Out any](prior Enumerable[T_In], collection func(T_In) Enumerable[T_Inner], result func(T_In, T_Inner) T_Out) Enumerable[T_Out] {
+	return &enumerableSelectMany[T_In, T_Inner, T_Out]{
+		Prior:      prior,
+		Collection: collection,
+		Result:     result,
+	}
+}
+
+// Flatten yields every item of each enumerable in prior. As for SelectMany,
+// a single-use enumerable in prior must not have been enumerated already.
+func Flatten[T any](prior Enumerable[Enumerable[T]]) Enumerable[T] {
+	return SelectMany(prior, func(x Enumerable[T]) Enumerable[T] { return x })
+}
@@ -0,0 +1,69 @@
+//go:build go1.23
+
+package enumerables
+
+import (
+	"iter"
+
+	cmn "github.com/alexmacinnes/golinq/common"
+)
+
+type enumerableFromSeq[T any] struct {
+	Seq iter.Seq[T]
+}
+
+func (this *enumerableFromSeq[T]) getAction() *actionDelegate[T] {
+	actionDelegate, ctx := newActionDelegate[T]()
+
+	action := func() {
+		defer close(actionDelegate.ResultChannel)
+
+		for x := range this.Seq {
+			if !sendResult(ctx, actionDelegate.ResultChannel, x) {
+				break // abort the current operation
+			}
+		}
+	}
+	actionDelegate.Action = action
+
+	return actionDelegate
+}
+
+func FromSeq[T any](seq iter.Seq[T]) Enumerable[T] {
+	return &enumerableFromSeq[T]{Seq: seq}
+}
+
+func FromSeq2[T_Key comparable, T_Value any](seq iter.Seq2[T_Key, T_Value]) Enumerable[cmn.KeyValuePair[T_Key, T_Value]] {
+	return FromSeq(func(yield func(cmn.KeyValuePair[T_Key, T_Value]) bool) {
+		for k, v := range seq {
+			if !yield(cmn.KeyValuePair[T_Key, T_Value]{Key: k, Value: v}) {
+				return
+			}
+		}
+	})
+}
+
+// ToSeq runs src for a range loop. Breaking out of the loop cancels src.
+func ToSeq[T any](src Enumerable[T]) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		resultChannel, cancelFunc := runAction(src)
+		defer cancelFunc()
+
+		for x := range resultChannel {
+			if !yield(x) {
+				return
+			}
+		}
+	}
+}
+
+// ToSeq2 is ToSeq for key value pairs, yielding each key and value separately.
+func ToSeq2[T_Key comparable, T_Value any](src Enumerable[cmn.KeyValuePair[T_Key, T_Value]]) iter.Seq2[T_Key, T_Value] {
+	return func(yield func(T_Key, T_Value) bool) {
+		for kvp := range ToSeq(src) {
+			if !yield(kvp.Key, kvp.Value) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,225 @@
+package enumerables
+
+type enumerableUnion[T any, T_Key comparable] struct {
+	First   Enumerable[T]
+	Second  Enumerable[T]
+	KeyFunc func(T) T_Key
+}
+
+func (this *enumerableUnion[T, T_Key]) getAction() *actionDelegate[T] {
+	actionDelegate, ctx := newActionDelegate[T]()
+	firstAction := this.First.getAction()
+	secondAction := this.Second.getAction()
+
+	action := func() {
+		defer close(actionDelegate.ResultChannel)
+
+		seen := make(map[T_Key]bool)
+
+		readPrior := func(chanIn chan T, cancelPrior func()) bool {
+			for x := range chanIn {
+				if actionIsCancelled(ctx) {
+					cancelPrior() // cancel the prior operation
+					return false
+				}
+				key := this.KeyFunc(x)
+				if !seen[key] {
+					seen[key] = true
+					if !sendResult(ctx, actionDelegate.ResultChannel, x) {
+						cancelPrior() // cancel the prior operation
+						return false
+					}
+				}
+			}
+			return true
+		}
+
+		// read first to the end, then second
+		go firstAction.Action()
+		if !readPrior(firstAction.ResultChannel, firstAction.CancelFunc) {
+			secondAction.CancelFunc()
+			return
+		}
+		go secondAction.Action()
+		readPrior(secondAction.ResultChannel, secondAction.CancelFunc)
+	}
+	actionDelegate.Action = action
+	releaseUnstarted(actionDelegate, firstAction.CancelFunc, secondAction.CancelFunc)
+
+	return actionDelegate
+}
+
+// Union yields the distinct items of first, followed by the distinct items
+// of second that were not in first.
+func Union[T comparable](first Enumerable[T], second Enumerable[T]) Enumerable[T] {
+	return UnionBy(first, second, func(x T) T { return x })
+}
+
+// UnionBy is Union, comparing items by the key returned by keyFunc.
+func UnionBy[T any, T_Key comparable](first Enumerable[T], second Enumerable[T], keyFunc func(T) T_Key) Enumerable[T] {
+	return &enumerableUnion[T, T_Key]{
+		First:   first,
+		Second:  second,
+		KeyFunc: keyFunc,
+	}
+}
+
+// enumerableFilterBySet streams Prior against the keys of Second, which is
+// read in full before Prior starts. Keep chooses between the items whose key
+// is in Second (Intersect) and those whose key is not (Except).
+type enumerableFilterBySet[T any, T_Key comparable] struct {
+	Prior   Enumerable[T]
+	Second  Enumerable[T]
+	KeyFunc func(T) T_Key
+	Keep    bool
+}
+
+func (this *enumerableFilterBySet[T, T_Key]) getAction() *actionDelegate[T] {
+	actionDelegate, ctx := newActionDelegate[T]()
+	priorAction := this.Prior.getAction()
+	secondAction := this.Second.getAction()
+
+	action := func() {
+		defer close(actionDelegate.ResultChannel)
+
+		secondItems, ok := drainAction(ctx, secondAction)
+		if !ok {
+			priorAction.CancelFunc() // cancel the prior operation
+			return
+		}
+		keys := make(map[T_Key]bool)
+		for _, x := range secondItems {
+			keys[this.KeyFunc(x)] = true
+		}
+
+		go priorAction.Action()
+		chanIn := priorAction.ResultChannel
+
+		for x := range chanIn {
+			if actionIsCancelled(ctx) {
+				priorAction.CancelFunc() // cancel the prior operation
+				break
+			}
+
+			// each key is yielded at most once, so remove it from the
+			// intersection or add it to the exclusions once seen
+			key := this.KeyFunc(x)
+			if keys[key] != this.Keep {
+				continue
+			}
+			if this.Keep {
+				delete(keys, key)
+			} else {
+				keys[key] = true
+			}
+
+			if !sendResult(ctx, actionDelegate.ResultChannel, x) {
+				priorAction.CancelFunc() // cancel the prior operation
+				break
+			}
+		}
+	}
+	actionDelegate.Action = action
+	releaseUnstarted(actionDelegate, priorAction.CancelFunc, secondAction.CancelFunc)
+
+	return actionDelegate
+}
+
+// Intersect yields the distinct items of first that are also in second.
+func Intersect[T comparable](first Enumerable[T], second Enumerable[T]) Enumerable[T] {
+	return IntersectBy(first, second, func(x T) T { return x })
+}
+
+// IntersectBy is Intersect, comparing items by the key returned by keyFunc.
+func IntersectBy[T any, T_Key comparable](first Enumerable[T], second Enumerable[T], keyFunc func(T) T_Key) Enumerable[T] {
+	return &enumerableFilterBySet[T, T_Key]{
+		Prior:   first,
+		Second:  second,
+		KeyFunc: keyFunc,
+		Keep:    true,
+	}
+}
+
+// Except yields the distinct items of first that are not in second.
+func Except[T comparable](first Enumerable[T], second Enumerable[T]) Enumerable[T] {
+	return ExceptBy(first, second, func(x T) T { return x })
+}
+
+// ExceptBy is Except, comparing items by the key returned by keyFunc.
+func ExceptBy[T any, T_Key comparable](first Enumerable[T], second Enumerable[T], keyFunc func(T) T_Key) Enumerable[T] {
+	return &enumerableFilterBySet[T, T_Key]{
+		Prior:   first,
+		Second:  second,
+		KeyFunc: keyFunc,
+		Keep:    false,
+	}
+}
+
+type enumerableSymmetricDifference[T comparable] struct {
+	Prior  Enumerable[T]
+	Second Enumerable[T]
+}
+
+func (this *enumerableSymmetricDifference[T]) getAction() *actionDelegate[T] {
+	actionDelegate, ctx := newActionDelegate[T]()
+	priorAction := this.Prior.getAction()
+	secondAction := this.Second.getAction()
+
+	action := func() {
+		defer close(actionDelegate.ResultChannel)
+
+		secondItems, ok := drainAction(ctx, secondAction)
+		if !ok {
+			priorAction.CancelFunc() // cancel the prior operation
+			return
+		}
+		secondSet := make(map[T]bool)
+		for _, x := range secondItems {
+			secondSet[x] = true
+		}
+
+		go priorAction.Action()
+		chanIn := priorAction.ResultChannel
+
+		firstSet := make(map[T]bool)
+		for x := range chanIn {
+			if actionIsCancelled(ctx) {
+				priorAction.CancelFunc() // cancel the prior operation
+				return
+			}
+			if firstSet[x] {
+				continue
+			}
+			firstSet[x] = true
+			if !secondSet[x] {
+				if !sendResult(ctx, actionDelegate.ResultChannel, x) {
+					priorAction.CancelFunc() // cancel the prior operation
+					return
+				}
+			}
+		}
+
+		for _, x := range secondItems {
+			if firstSet[x] {
+				continue
+			}
+			firstSet[x] = true
+			if !sendResult(ctx, actionDelegate.ResultChannel, x) {
+				return
+			}
+		}
+	}
+	actionDelegate.Action = action
+	releaseUnstarted(actionDelegate, priorAction.CancelFunc, secondAction.CancelFunc)
+
+	return actionDelegate
+}
+
+// SymmetricDifference yields the distinct items of first that are not in
+// second, followed by the distinct items of second that are not in first.
+func SymmetricDifference[T comparable](first Enumerable[T], second Enumerable[T]) Enumerable[T] {
+	return &enumerableSymmetricDifference[T]{
+		Prior:  first,
+		Second: second,
+	}
+}
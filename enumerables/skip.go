@@ -0,0 +1,139 @@
+package enumerables
+
+type enumerableSkip[T any] struct {
+	Prior Enumerable[T]
+	Count int
+}
+
+type enumerableSkipWhile[T any] struct {
+	Prior     Enumerable[T]
+	Predicate func(T) bool
+}
+
+type enumerableSkipLast[T any] struct {
+	Prior Enumerable[T]
+	Count int
+}
+
+func (this *enumerableSkip[T]) getAction() *actionDelegate[T] {
+	actionDelegate, ctx := newActionDelegate[T]()
+	priorAction := this.Prior.getAction()
+
+	action := func() {
+		defer close(actionDelegate.ResultChannel)
+
+		go priorAction.Action()
+		chanIn := priorAction.ResultChannel
+
+		skipped := 0
+		for x := range chanIn {
+			if actionIsCancelled(ctx) {
+				priorAction.CancelFunc() // cancel the prior operation
+				break
+			}
+			if skipped < this.Count {
+				skipped++
+				continue
+			}
+			if !sendResult(ctx, actionDelegate.ResultChannel, x) {
+				priorAction.CancelFunc() // cancel the prior operation
+				break
+			}
+		}
+	}
+	actionDelegate.Action = action
+	releaseUnstarted(actionDelegate, priorAction.CancelFunc)
+
+	return actionDelegate
+}
+
+func (this *enumerableSkipWhile[T]) getAction() *actionDelegate[T] {
+	actionDelegate, ctx := newActionDelegate[T]()
+	priorAction := this.Prior.getAction()
+
+	action := func() {
+		defer close(actionDelegate.ResultChannel)
+
+		go priorAction.Action()
+		chanIn := priorAction.ResultChannel
+
+		skipping := true
+		for x := range chanIn {
+			if actionIsCancelled(ctx) {
+				priorAction.CancelFunc() // cancel the prior operation
+				break
+			}
+			if skipping && this.Predicate(x) {
+				continue
+			}
+			skipping = false
+			if !sendResult(ctx, actionDelegate.ResultChannel, x) {
+				priorAction.CancelFunc() // cancel the prior operation
+				break
+			}
+		}
+	}
+	actionDelegate.Action = action
+	releaseUnstarted(actionDelegate, priorAction.CancelFunc)
+
+	return actionDelegate
+}
+
+func (this *enumerableSkipLast[T]) getAction() *actionDelegate[T] {
+	actionDelegate, ctx := newActionDelegate[T]()
+	priorAction := this.Prior.getAction()
+
+	action := func() {
+		defer close(actionDelegate.ResultChannel)
+
+		count := this.Count
+		if count < 0 {
+			count = 0
+		}
+		buffer := newRingBuffer[T](count)
+
+		go priorAction.Action()
+		chanIn := priorAction.ResultChannel
+
+		for x := range chanIn {
+			if actionIsCancelled(ctx) {
+				priorAction.CancelFunc() // cancel the prior operation
+				break
+			}
+
+			// an item is only sent once count newer items are held back
+			evicted, full := buffer.push(x)
+			if full && !sendResult(ctx, actionDelegate.ResultChannel, evicted) {
+				priorAction.CancelFunc() // cancel the prior operation
+				break
+			}
+		}
+	}
+	actionDelegate.Action = action
+	releaseUnstarted(actionDelegate, priorAction.CancelFunc)
+
+	return actionDelegate
+}
+
+func Skip[T any](prior Enumerable[T], count int) Enumerable[T] {
+	return &enumerableSkip[T]{
+		Prior: prior,
+		Count: count,
+	}
+}
+
+func SkipWhile[T any](prior Enumerable[T], predicate func(T) bool) Enumerable[T] {
+	return &enumerableSkipWhile[T]{
+		Prior:     prior,
+		Predicate: predicate,
+	}
+}
+
+// SkipLast yields all but the final count items, buffering no more than
+// count at once.
+func SkipLast[T any](prior Enumerable[T], count int) Enumerable[T] {
+	return &enumerableSkipLast[T]{
+		Prior: prior,
+		Count: count,
+	}
+}
@@ -0,0 +1,51 @@
+package enumerables
+
+import (
+	"sort"
+
+	cmn "github.com/alexmacinnes/golinq/common"
+)
+
+type enumerableFromMapSorted[T_Key comparable, T_Value any] struct {
+	Input *map[T_Key]T_Value
+	Less  func(T_Key, T_Key) bool
+}
+
+func (this *enumerableFromMapSorted[T_Key, T_Value]) getAction() *actionDelegate[cmn.KeyValuePair[T_Key, T_Value]] {
+	actionDelegate, ctx := newActionDelegate[cmn.KeyValuePair[T_Key, T_Value]]()
+
+	action := func() {
+		defer close(actionDelegate.ResultChannel)
+
+		keys := make([]T_Key, 0, len(*this.Input))
+		for k := range *this.Input {
+			keys = append(keys, k)
+		}
+		sort.Slice(keys, func(i, j int) bool { return this.Less(keys[i], keys[j]) })
+
+		for _, k := range keys {
+			kvp := cmn.KeyValuePair[T_Key, T_Value]{
+				Key:   k,
+				Value: (*this.Input)[k],
+			}
+			if !sendResult(ctx, actionDelegate.ResultChannel, kvp) {
+				break // abort the current operation
+			}
+		}
+	}
+	actionDelegate.Action = action
+
+	return actionDelegate
+}
+
+// FromMapSorted is FromMap, but yields entries in ascending key order.
+func FromMapSorted[T_Key cmn.Ordered, T_Value any](input *map[T_Key]T_Value) Enumerable[cmn.KeyValuePair[T_Key, T_Value]] {
+	return FromMapSortedFunc(input, func(a T_Key, b T_Key) bool { return a < b })
+}
+
+// FromMapSortedFunc is FromMap, but yields entries in the key order defined
+// by less, which must order every pair of distinct keys for the result to
+// be deterministic.
+func FromMapSortedFunc[T_Key comparable, T_Value any](input *map[T_Key]T_Value, less func(T_Key, T_Key) bool) Enumerable[cmn.KeyValuePair[T_Key, T_Value]] {
+	return &enumerableFromMapSorted[T_Key, T_Value]{Input: input, Less: less}
+}
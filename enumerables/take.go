@@ -0,0 +1,134 @@
+package enumerables
+
+type enumerableTake[T any] struct {
+	Prior Enumerable[T]
+	Count int
+}
+
+type enumerableTakeWhile[T any] struct {
+	Prior     Enumerable[T]
+	Predicate func(T) bool
+}
+
+type enumerableTakeLast[T any] struct {
+	Prior Enumerable[T]
+	Count int
+}
+
+func (this *enumerableTake[T]) getAction() *actionDelegate[T] {
+	actionDelegate, ctx := newActionDelegate[T]()
+	priorAction := this.Prior.getAction()
+
+	action := func() {
+		defer close(actionDelegate.ResultChannel)
+
+		if this.Count <= 0 {
+			priorAction.CancelFunc() // nothing to take, release the prior operation
+			return
+		}
+
+		go priorAction.Action()
+		chanIn := priorAction.ResultChannel
+
+		taken := 0
+		for x := range chanIn {
+			if !sendResult(ctx, actionDelegate.ResultChannel, x) {
+				priorAction.CancelFunc() // cancel the prior operation
+				return
+			}
+			taken++
+			if taken == this.Count {
+				priorAction.CancelFunc() // satisfied, stop the prior operation
+				return
+			}
+		}
+	}
+	actionDelegate.Action = action
+	releaseUnstarted(actionDelegate, priorAction.CancelFunc)
+
+	return actionDelegate
+}
+
+func (this *enumerableTakeWhile[T]) getAction() *actionDelegate[T] {
+	actionDelegate, ctx := newActionDelegate[T]()
+	priorAction := this.Prior.getAction()
+
+	action := func() {
+		defer close(actionDelegate.ResultChannel)
+
+		go priorAction.Action()
+		chanIn := priorAction.ResultChannel
+
+		for x := range chanIn {
+			if !this.Predicate(x) || !sendResult(ctx, actionDelegate.ResultChannel, x) {
+				priorAction.CancelFunc() // cancel the prior operation
+				return
+			}
+		}
+	}
+	actionDelegate.Action = action
+	releaseUnstarted(actionDelegate, priorAction.CancelFunc)
+
+	return actionDelegate
+}
+
+func (this *enumerableTakeLast[T]) getAction() *actionDelegate[T] {
+	actionDelegate, ctx := newActionDelegate[T]()
+	priorAction := this.Prior.getAction()
+
+	action := func() {
+		defer close(actionDelegate.ResultChannel)
+
+		count := this.Count
+		if count < 0 {
+			count = 0
+		}
+		buffer := newRingBuffer[T](count)
+
+		go priorAction.Action()
+		chanIn := priorAction.ResultChannel
+
+		for x := range chanIn {
+			if actionIsCancelled(ctx) {
+				priorAction.CancelFunc() // cancel the prior operation
+				return
+			}
+			buffer.push(x)
+		}
+
+		for {
+			x, ok := buffer.pop()
+			if !ok || !sendResult(ctx, actionDelegate.ResultChannel, x) {
+				return
+			}
+		}
+	}
+	actionDelegate.Action = action
+	releaseUnstarted(actionDelegate, priorAction.CancelFunc)
+
+	return actionDelegate
+}
+
+// Take yields the first count items, cancelling prior as soon as it has them.
+func Take[T any](prior Enumerable[T], count int) Enumerable[T] {
+	return &enumerableTake[T]{
+		Prior: prior,
+		Count: count,
+	}
+}
+
+// TakeWhile yields items until predicate first fails, then cancels prior.
+func TakeWhile[T any](prior Enumerable[T], predicate func(T) bool) Enumerable[T] {
+	return &enumerableTakeWhile[T]{
+		Prior:     prior,
+		Predicate: predicate,
+	}
+}
+
+// TakeLast yields the final count items, buffering no more than count at once.
+func TakeLast[T any](prior Enumerable[T], count int) Enumerable[T] {
+	return &enumerableTakeLast[T]{
+		Prior: prior,
+		Count: count,
+	}
+}
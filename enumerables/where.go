@@ -7,12 +7,11 @@ type enumerableWhere[T any] struct {
 
 func (this *enumerableWhere[T]) getAction() *actionDelegate[T] {
 	actionDelegate, ctx := newActionDelegate[T]()
+	priorAction := this.Prior.getAction()
 
 	action := func() {
 		defer close(actionDelegate.ResultChannel)
 
-		priorAction := this.Prior.getAction()
-
 		go priorAction.Action()
 		chanIn := priorAction.ResultChannel
 
@@ -22,11 +21,15 @@ func (this *enumerableWhere[T]) getAction() *actionDelegate[T] {
 				break
 			}
 			if this.Predicate(x) {
-				actionDelegate.ResultChannel <- x
+				if !sendResult(ctx, actionDelegate.ResultChannel, x) {
+					priorAction.CancelFunc() // cancel the prior operation
+					break
+				}
 			}
 		}
 	}
 	actionDelegate.Action = action
+	releaseUnstarted(actionDelegate, priorAction.CancelFunc)
 
 	return actionDelegate
 }
@@ -37,3 +40,49 @@ func Where[T any](prior Enumerable[T], predicate func(T) bool) Enumerable[T] {
 		Predicate: predicate,
 	}
 }
+
+type enumerableWhereIndexed[T any] struct {
+	Prior     Enumerable[T]
+	Predicate func(T, int) bool
+}
+
+func (this *enumerableWhereIndexed[T]) getAction() *actionDelegate[T] {
+	actionDelegate, ctx := newActionDelegate[T]()
+	priorAction := this.Prior.getAction()
+
+	action := func() {
+		defer close(actionDelegate.ResultChannel)
+
+		go priorAction.Action()
+		chanIn := priorAction.ResultChannel
+
+		index := 0
+		for x := range chanIn {
+			if actionIsCancelled(ctx) {
+				priorAction.CancelFunc() // cancel the prior operation
+				break
+			}
+			match := this.Predicate(x, index)
+			index++
+			if match {
+				if !sendResult(ctx, actionDelegate.ResultChannel, x) {
+					priorAction.CancelFunc() // cancel the prior operation
+					break
+				}
+			}
+		}
+	}
+	actionDelegate.Action = action
+	releaseUnstarted(actionDelegate, priorAction.CancelFunc)
+
+	return actionDelegate
+}
+
+// WhereIndexed is Where, but also passes the position of each item in prior,
+// counting from zero on every enumeration.
+func WhereIndexed[T any](prior Enumerable[T], predicate func(T, int) bool) Enumerable[T] {
+	return &enumerableWhereIndexed[T]{
+		Prior:     prior,
+		Predicate: predicate,
+	}
+}
@@ -0,0 +1,92 @@
+package enumerables
+
+import cmn "github.com/alexmacinnes/golinq/common"
+
+type enumerableWindow[T any] struct {
+	Prior   Enumerable[T]
+	Size    uint32
+	Step    uint32
+	Partial bool
+}
+
+func (this *enumerableWindow[T]) getAction() *actionDelegate[[]T] {
+	actionDelegate, ctx := newActionDelegate[[]T]()
+	priorAction := this.Prior.getAction()
+
+	action := func() {
+		defer close(actionDelegate.ResultChannel)
+
+		buffer := newRingBuffer[T](int(this.Size))
+		skip := uint32(0)
+
+		// drop the items the next window does not share with the last
+		advance := func() {
+			for i := uint32(0); i < this.Step && buffer.len() > 0; i++ {
+				buffer.pop()
+			}
+			if this.Step > this.Size {
+				skip = this.Step - this.Size
+			}
+		}
+
+		go priorAction.Action()
+		chanIn := priorAction.ResultChannel
+
+		for x := range chanIn {
+			if actionIsCancelled(ctx) {
+				priorAction.CancelFunc() // cancel the prior operation
+				return
+			}
+			if skip > 0 {
+				skip--
+				continue
+			}
+
+			buffer.push(x)
+			if buffer.full() {
+				window := buffer.copyOut()
+				advance()
+				if !sendResult(ctx, actionDelegate.ResultChannel, window) {
+					priorAction.CancelFunc() // cancel the prior operation
+					return
+				}
+			}
+		}
+
+		for this.Partial && buffer.len() > 0 {
+			window := buffer.copyOut()
+			advance()
+			if !sendResult(ctx, actionDelegate.ResultChannel, window) {
+				return
+			}
+		}
+	}
+	actionDelegate.Action = action
+	releaseUnstarted(actionDelegate, priorAction.CancelFunc)
+
+	return actionDelegate
+}
+
+// Window yields slices of size consecutive items, starting a new window
+// every step items, so windows overlap when step is less than size. When
+// partial is set, the shorter windows that start near the end are yielded
+// too. Window panics with cmn.ErrInvalidWindow if size or step is zero.
+func Window[T any](prior Enumerable[T], size uint32, step uint32, partial bool) Enumerable[[]T] {
+	if size == 0 || step == 0 {
+		panic(cmn.ErrInvalidWindow)
+	}
+
+	return &enumerableWindow[T]{
+		Prior:   prior,
+		Size:    size,
+		Step:    step,
+		Partial: partial,
+	}
+}
+
+// Pairwise yields each item paired with the item after it.
+func Pairwise[T any](prior Enumerable[T]) Enumerable[cmn.Pair[T, T]] {
+	return Select(Window(prior, 2, 1, false), func(x []T) cmn.Pair[T, T] {
+		return cmn.Pair[T, T]{First: x[0], Second: x[1]}
+	})
+}
@@ -0,0 +1,107 @@
+package enumerables
+
+import cmn "github.com/alexmacinnes/golinq/common"
+
+type enumerableZip[T_First any, T_Second any, T_Out any] struct {
+	First         Enumerable[T_First]
+	Second        Enumerable[T_Second]
+	Result        func(T_First, T_Second) T_Out
+	Longest       bool
+	DefaultFirst  T_First
+	DefaultSecond T_Second
+}
+
+func (this *enumerableZip[T_First, T_Second, T_Out]) getAction() *actionDelegate[T_Out] {
+	actionDelegate, ctx := newActionDelegate[T_Out]()
+	firstAction := this.First.getAction()
+	secondAction := this.Second.getAction()
+
+	action := func() {
+		defer close(actionDelegate.ResultChannel)
+		// whichever side is still running when the zip ends is cancelled
+		defer firstAction.CancelFunc()
+		defer secondAction.CancelFunc()
+
+		go firstAction.Action()
+		go secondAction.Action()
+
+		firstDone, secondDone := false, false
+		for {
+			first, second := this.DefaultFirst, this.DefaultSecond
+			if !firstDone {
+				next, ok := <-firstAction.ResultChannel
+				if ok {
+					first = next
+				} else {
+					firstDone = true
+					if !this.Longest {
+						return
+					}
+				}
+			}
+			if !secondDone {
+				next, ok := <-secondAction.ResultChannel
+				if ok {
+					second = next
+				} else {
+					secondDone = true
+					if !this.Longest {
+						return
+					}
+				}
+			}
+
+			if firstDone && secondDone {
+				return
+			}
+			if actionIsCancelled(ctx) {
+				return
+			}
+			if !sendResult(ctx, actionDelegate.ResultChannel, this.Result(first, second)) {
+				return
+			}
+		}
+	}
+	actionDelegate.Action = action
+	releaseUnstarted(actionDelegate, firstAction.CancelFunc, secondAction.CancelFunc)
+
+	return actionDelegate
+}
+
+// Zip pairs up the items of first and second by position, stopping when
+// either runs out.
+func Zip[T_First any, T_Second any](first Enumerable[T_First], second Enumerable[T_Second]) Enumerable[cmn.Pair[T_First, T_Second]] {
+	return ZipWith(first, second, func(a T_First, b T_Second) cmn.Pair[T_First, T_Second] {
+		return cmn.Pair[T_First, T_Second]{First: a, Second: b}
+	})
+}
+
+// ZipWith is Zip, combining each pair of items through result.
+func ZipWith[T_First any, T_Second any, T_Out any](first Enumerable[T_First], second Enumerable[T_Second], result func(T_First, T_Second) T_Out) Enumerable[T_Out] {
+	return &enumerableZip[T_First, T_Second, T_Out]{
+		First:  first,
+		Second: second,
+		Result: result,
+	}
+}
+
+func Zip3[T_First any, T_Second any, T_Third any](first Enumerable[T_First], second Enumerable[T_Second], third Enumerable[T_Third]) Enumerable[cmn.Triple[T_First, T_Second, T_Third]] {
+	return ZipWith(Zip(first, second), third, func(p cmn.Pair[T_First, T_Second], c T_Third) cmn.Triple[T_First, T_Second, T_Third] {
+		return cmn.Triple[T_First, T_Second, T_Third]{First: p.First, Second: p.Second, Third: c}
+	})
+}
+
+// ZipLongest is Zip, carrying on until both sides run out. The side that
+// ends first is filled with its default value.
+func ZipLongest[T_First any, T_Second any](first Enumerable[T_First], second Enumerable[T_Second], defaultFirst T_First, defaultSecond T_Second) Enumerable[cmn.Pair[T_First, T_Second]] {
+	return &enumerableZip[T_First, T_Second, cmn.Pair[T_First, T_Second]]{
+		First:  first,
+		Second: second,
+		Result: func(a T_First, b T_Second) cmn.Pair[T_First, T_Second] {
+			return cmn.Pair[T_First, T_Second]{First: a, Second: b}
+		},
+		Longest:       true,
+		DefaultFirst:  defaultFirst,
+		DefaultSecond: defaultSecond,
+	}
+}
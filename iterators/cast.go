@@ -0,0 +1,94 @@
+package iterators
+
+import (
+	"fmt"
+	"reflect"
+
+	cmn "github.com/alexmacinnes/golinq/common"
+)
+
+type itrOfType[T_In any, T_Out any] struct {
+	Inner itr[T_In]
+}
+
+func (x *itrOfType[T_In, T_Out]) Next() (T_Out, bool) {
+	for {
+		next, ok := x.Inner.Next()
+		if !ok {
+			var none T_Out
+			return none, false
+		}
+
+		if converted, ok := any(next).(T_Out); ok {
+			return converted, true
+		}
+	}
+}
+
+func (x *itrOfType[T_In, T_Out]) Close() {
+	closeItr(x.Inner)
+}
+
+type iteratorOfType[T_In any, T_Out any] struct {
+	Inner Iterator[T_In]
+}
+
+func (x *iteratorOfType[T_In, T_Out]) initItr() itr[T_Out] {
+	return &itrOfType[T_In, T_Out]{
+		Inner: x.Inner.initItr(),
+	}
+}
+
+// OfType yields the items of inner that hold a T_Out, skipping the rest.
+func OfType[T_In any, T_Out any](inner Iterator[T_In]) Iterator[T_Out] {
+	return &iteratorOfType[T_In, T_Out]{
+		Inner: inner,
+	}
+}
+
+type itrCast[T_In any, T_Out any] struct {
+	Inner itr[T_In]
+	index int
+}
+
+func (x *itrCast[T_In, T_Out]) Next() (T_Out, bool) {
+	next, ok := x.Inner.Next()
+	if !ok {
+		var none T_Out
+		return none, false
+	}
+
+	converted, ok := any(next).(T_Out)
+	if !ok {
+		panic(&cmn.CastError{
+			Index:  x.index,
+			Type:   fmt.Sprintf("%T", next),
+			Target: reflect.TypeOf((*T_Out)(nil)).Elem().String(),
+		})
+	}
+
+	x.index++
+	return converted, true
+}
+
+func (x *itrCast[T_In, T_Out]) Close() {
+	closeItr(x.Inner)
+}
+
+type iteratorCast[T_In any, T_Out any] struct {
+	Inner Iterator[T_In]
+}
+
+func (x *iteratorCast[T_In, T_Out]) initItr() itr[T_Out] {
+	return &itrCast[T_In, T_Out]{
+		Inner: x.Inner.initItr(),
+	}
+}
+
+// Cast converts every item of inner to T_Out. Reading an item that does not
+// hold a T_Out panics with a *cmn.CastError; use OfType to skip such items.
+func Cast[T_In any, T_Out any](inner Iterator[T_In]) Iterator[T_Out] {
+	return &iteratorCast[T_In, T_Out]{
+		Inner: inner,
+	}
+}
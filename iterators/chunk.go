@@ -32,6 +32,10 @@ func (x *itrChunk[T]) Next() ([]T, bool) {
 	}
 }
 
+func (x *itrChunk[T]) Close() {
+	closeItr(x.Inner)
+}
+
 type iteratorChunk[T any] struct {
 	Inner     Iterator[T]
 	ChunkSize uint32
@@ -2,12 +2,14 @@ package iterators
 
 func Any[T any](src Iterator[T]) bool {
 	itr := src.initItr()
+	defer closeItr(itr)
 	_, ok := itr.Next()
 	return ok
 }
 
 func All[T any](src Iterator[T], predicate func(T) bool) bool {
 	itr := src.initItr()
+	defer closeItr(itr)
 
 	for {
 		next, ok := itr.Next()
@@ -23,6 +25,7 @@ func All[T any](src Iterator[T], predicate func(T) bool) bool {
 
 func Contains[T comparable](src Iterator[T], item T) bool {
 	itr := src.initItr()
+	defer closeItr(itr)
 
 	for {
 		next, ok := itr.Next()
@@ -37,6 +40,7 @@ func Contains[T comparable](src Iterator[T], item T) bool {
 
 func ElementAt[T any](src Iterator[T], index int) (T, bool) {
 	itr := src.initItr()
+	defer closeItr(itr)
 	var result T
 	var ok bool
 
@@ -52,18 +56,30 @@ func ElementAt[T any](src Iterator[T], index int) (T, bool) {
 }
 
 func First[T any](src Iterator[T]) (T, bool) {
+	if ordered, ok := src.(OrderedIterator[T]); ok {
+		return ordered.first()
+	}
+
 	itr := src.initItr()
+	defer closeItr(itr)
 	return itr.Next()
 }
 
 func FirstOrDefault[T any](src Iterator[T]) T {
+	if ordered, ok := src.(OrderedIterator[T]); ok {
+		result, _ := ordered.first()
+		return result
+	}
+
 	itr := src.initItr()
+	defer closeItr(itr)
 	result, _ := itr.Next()
 	return result
 }
 
 func Single[T any](src Iterator[T]) (T, bool) {
 	itr := src.initItr()
+	defer closeItr(itr)
 
 	result, ok := itr.Next()
 
@@ -81,6 +97,7 @@ func Single[T any](src Iterator[T]) (T, bool) {
 
 func SingleOrDefault[T any](src Iterator[T]) (T, bool) {
 	itr := src.initItr()
+	defer closeItr(itr)
 
 	result, ok := itr.Next()
 
@@ -99,6 +116,7 @@ func SingleOrDefault[T any](src Iterator[T]) (T, bool) {
 
 func Last[T any](src Iterator[T]) (T, bool) {
 	itr := src.initItr()
+	defer closeItr(itr)
 
 	result, ok := itr.Next()
 
@@ -117,6 +135,7 @@ func Last[T any](src Iterator[T]) (T, bool) {
 
 func LastOrDefault[T any](src Iterator[T]) T {
 	itr := src.initItr()
+	defer closeItr(itr)
 
 	result, ok := itr.Next()
 
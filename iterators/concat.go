@@ -0,0 +1,66 @@
+package iterators
+
+type itrConcat[T any] struct {
+	Sources []Iterator[T]
+	current itr[T]
+	index   int
+}
+
+func (x *itrConcat[T]) Next() (T, bool) {
+	for {
+		if x.current == nil {
+			if x.index >= len(x.Sources) {
+				var none T
+				return none, false
+			}
+			x.current = x.Sources[x.index].initItr()
+			x.index++
+		}
+
+		next, ok := x.current.Next()
+		if ok {
+			return next, true
+		}
+
+		closeItr(x.current)
+		x.current = nil
+	}
+}
+
+func (x *itrConcat[T]) Close() {
+	if x.current != nil {
+		closeItr(x.current)
+		x.current = nil
+	}
+	// sources never reached are opened just to close them, so that one
+	// such as FromRows still releases its resource
+	for ; x.index < len(x.Sources); x.index++ {
+		closeItr(x.Sources[x.index].initItr())
+	}
+}
+
+type iteratorConcat[T any] struct {
+	Sources []Iterator[T]
+}
+
+func (x *iteratorConcat[T]) initItr() itr[T] {
+	return &itrConcat[T]{
+		Sources: x.Sources,
+	}
+}
+
+// Concat yields every item of each source in turn. A source is not started
+// until the one before it is exhausted.
+func Concat[T any](sources ...Iterator[T]) Iterator[T] {
+	return &iteratorConcat[T]{
+		Sources: sources,
+	}
+}
+
+func Append[T any](inner Iterator[T], items ...T) Iterator[T] {
+	return Concat(inner, FromSlice(&items))
+}
+
+func Prepend[T any](inner Iterator[T], items ...T) Iterator[T] {
+	return Concat(FromSlice(&items), inner)
+}
@@ -0,0 +1,138 @@
+package iterators
+
+import (
+	"encoding/csv"
+	"errors"
+	"io"
+	"sync/atomic"
+
+	cmn "github.com/alexmacinnes/golinq/common"
+)
+
+type itrFromCSV[T any] struct {
+	Reader     *csv.Reader
+	Policy     cmn.ErrorPolicy
+	ReadHeader func([]string) error
+	Parse      func([]string) (T, error)
+	Errors     *sourceError
+	collected  cmn.RowErrors
+	headerRead bool
+	done       bool
+}
+
+func (x *itrFromCSV[T]) Next() (T, bool) {
+	var none T
+
+	for !x.done {
+		record, err := x.Reader.Read()
+		if err == io.EOF {
+			x.finish(nil)
+			break
+		}
+
+		var line int
+		var parseErr *csv.ParseError
+		if errors.As(err, &parseErr) {
+			line, err = parseErr.Line, parseErr.Err
+		} else if err != nil {
+			x.finish(err) // the reader itself failed, so no later rows can be read
+			break
+		} else {
+			line, _ = x.Reader.FieldPos(0)
+		}
+
+		if x.ReadHeader != nil && !x.headerRead {
+			x.headerRead = true
+			if err == nil {
+				err = x.ReadHeader(record)
+			}
+			if err != nil {
+				x.finish(&cmn.RowError{Line: line, Err: err})
+			}
+			continue
+		}
+
+		var result T
+		if err == nil {
+			result, err = x.Parse(record)
+		}
+		if err == nil {
+			return result, true
+		}
+
+		rowErr := &cmn.RowError{Line: line, Err: err}
+		switch x.Policy {
+		case cmn.SkipErrors:
+		case cmn.CollectErrors:
+			x.collected = append(x.collected, rowErr)
+		default:
+			x.finish(rowErr)
+		}
+	}
+
+	return none, false
+}
+
+func (x *itrFromCSV[T]) finish(err error) {
+	x.done = true
+	if err == nil && len(x.collected) > 0 {
+		err = x.collected
+	}
+	x.Errors.setErr(err)
+}
+
+type iteratorFromCSV[T any] struct {
+	sourceError
+	Reader     *csv.Reader
+	Policy     cmn.ErrorPolicy
+	ReadHeader func([]string) error
+	Parse      func([]string) (T, error)
+	consumed   atomic.Bool
+}
+
+func (x *iteratorFromCSV[T]) initItr() itr[T] {
+	if x.consumed.Swap(true) {
+		panic(cmn.ErrAlreadyEnumerated)
+	}
+
+	return &itrFromCSV[T]{
+		Reader:     x.Reader,
+		Policy:     x.Policy,
+		ReadHeader: x.ReadHeader,
+		Parse:      x.Parse,
+		Errors:     &x.sourceError,
+	}
+}
+
+// FromCSVRecords yields every record of reader, including any header row.
+// Malformed rows are handled according to policy and reported through the
+// returned error func. The reader can only be read once, so the result is
+// single-use.
+func FromCSVRecords(reader *csv.Reader, policy cmn.ErrorPolicy) (Iterator[[]string], func() error) {
+	result := &iteratorFromCSV[[]string]{
+		Reader: reader,
+		Policy: policy,
+		Parse:  func(record []string) ([]string, error) { return record, nil },
+	}
+	return result, result.Err
+}
+
+// FromCSV reads a header row from reader and maps each following record
+// into a T, as described by cmn.CSVMapper. Rows that fail to parse are
+// handled according to policy and reported through the returned error func.
+// The reader can only be read once, so the result is single-use.
+func FromCSV[T any](reader *csv.Reader, policy cmn.ErrorPolicy) (Iterator[T], func() error) {
+	var mapper *cmn.CSVMapper[T]
+
+	result := &iteratorFromCSV[T]{
+		Reader: reader,
+		Policy: policy,
+		ReadHeader: func(header []string) error {
+			var err error
+			mapper, err = cmn.NewCSVMapper[T](header)
+			return err
+		},
+		Parse: func(record []string) (T, error) { return mapper.Map(record) },
+	}
+	return result, result.Err
+}
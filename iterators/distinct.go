@@ -20,6 +20,10 @@ func (x *itrDistinct[T]) Next() (T, bool) {
 	}
 }
 
+func (x *itrDistinct[T]) Close() {
+	closeItr(x.Inner)
+}
+
 type iteratorDistinct[T comparable] struct {
 	Inner Iterator[T]
 }
@@ -36,3 +40,103 @@ func Distinct[T comparable](inner Iterator[T]) Iterator[T] {
 		Inner: inner,
 	}
 }
+
+type itrDistinctBy[T any, T_Key comparable] struct {
+	Inner        itr[T]
+	KeyFunc      func(T) T_Key
+	previousKeys map[T_Key]interface{}
+}
+
+func (x *itrDistinctBy[T, T_Key]) Next() (T, bool) {
+	for {
+		next, ok := x.Inner.Next()
+		if !ok {
+			return next, false
+		}
+
+		key := x.KeyFunc(next)
+		_, exists := x.previousKeys[key]
+		if !exists {
+			x.previousKeys[key] = true
+			return next, true
+		}
+	}
+}
+
+func (x *itrDistinctBy[T, T_Key]) Close() {
+	closeItr(x.Inner)
+}
+
+type iteratorDistinctBy[T any, T_Key comparable] struct {
+	Inner   Iterator[T]
+	KeyFunc func(T) T_Key
+}
+
+func (x *iteratorDistinctBy[T, T_Key]) initItr() itr[T] {
+	return &itrDistinctBy[T, T_Key]{
+		Inner:        x.Inner.initItr(),
+		KeyFunc:      x.KeyFunc,
+		previousKeys: make(map[T_Key]interface{}),
+	}
+}
+
+// DistinctBy yields the first item of inner for each key returned by keyFunc.
+func DistinctBy[T any, T_Key comparable](inner Iterator[T], keyFunc func(T) T_Key) Iterator[T] {
+	return &iteratorDistinctBy[T, T_Key]{
+		Inner:   inner,
+		KeyFunc: keyFunc,
+	}
+}
+
+type itrDistinctUntilChanged[T any, T_Key comparable] struct {
+	Inner       itr[T]
+	KeyFunc     func(T) T_Key
+	previousKey T_Key
+	started     bool
+}
+
+func (x *itrDistinctUntilChanged[T, T_Key]) Next() (T, bool) {
+	for {
+		next, ok := x.Inner.Next()
+		if !ok {
+			return next, false
+		}
+
+		key := x.KeyFunc(next)
+		if !x.started || key != x.previousKey {
+			x.started = true
+			x.previousKey = key
+			return next, true
+		}
+	}
+}
+
+func (x *itrDistinctUntilChanged[T, T_Key]) Close() {
+	closeItr(x.Inner)
+}
+
+type iteratorDistinctUntilChanged[T any, T_Key comparable] struct {
+	Inner   Iterator[T]
+	KeyFunc func(T) T_Key
+}
+
+func (x *iteratorDistinctUntilChanged[T, T_Key]) initItr() itr[T] {
+	return &itrDistinctUntilChanged[T, T_Key]{
+		Inner:   x.Inner.initItr(),
+		KeyFunc: x.KeyFunc,
+	}
+}
+
+// DistinctUntilChanged drops items equal to the item immediately before them.
+func DistinctUntilChanged[T comparable](inner Iterator[T]) Iterator[T] {
+	return DistinctUntilChangedBy(inner, func(x T) T { return x })
+}
+
+// DistinctUntilChangedBy drops items whose key equals the key of the item
+// immediately before them.
+func DistinctUntilChangedBy[T any, T_Key comparable](inner Iterator[T], keyFunc func(T) T_Key) Iterator[T] {
+	return &iteratorDistinctUntilChanged[T, T_Key]{
+		Inner:   inner,
+		KeyFunc: keyFunc,
+	}
+}
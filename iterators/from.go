@@ -1,6 +1,11 @@
 package iterators
 
-import cmn "github.com/alexmacinnes/golinq/common"
+import (
+	"context"
+	"sync/atomic"
+
+	cmn "github.com/alexmacinnes/golinq/common"
+)
 
 //Slice to Itr
 type itrFromSlice[T any] struct {
@@ -57,22 +62,67 @@ func (x *ptrIteratorFromSlice[T]) initItr() itr[*T] {
 	}
 }
 
-type iteratorFromMap[T_Key comparable, T_Value any] struct {
+//Map keys to Itr
+type itrFromMapKeys[T_Key comparable, T_Value any] struct {
 	Input *map[T_Key]T_Value
+	Keys  []T_Key
+	index int
 }
 
-func (x *iteratorFromMap[T_Key, T_Value]) initItr() itr[cmn.KeyValuePair[T_Key, T_Value]] {
-	inputChannel := make(chan cmn.KeyValuePair[T_Key, T_Value])
+func (x *itrFromMapKeys[T_Key, T_Value]) Next() (cmn.KeyValuePair[T_Key, T_Value], bool) {
+	for x.index < len(x.Keys) {
+		key := x.Keys[x.index]
+		x.index++
+
+		// skip keys deleted since the snapshot was taken
+		value, ok := (*x.Input)[key]
+		if ok {
+			return cmn.KeyValuePair[T_Key, T_Value]{Key: key, Value: value}, true
+		}
+	}
+
+	return cmn.KeyValuePair[T_Key, T_Value]{}, false
+}
+
+//Map keys to PtrItr
+type ptrItrFromMapKeys[T_Key comparable, T_Value any] struct {
+	Input *map[T_Key]T_Value
+	Keys  []T_Key
+	index int
+}
+
+func (x *ptrItrFromMapKeys[T_Key, T_Value]) Next() (cmn.KeyValuePair[T_Key, *T_Value], bool) {
+	for x.index < len(x.Keys) {
+		key := x.Keys[x.index]
+		x.index++
 
-	go func() {
-		for k, v := range *(x.Input) {
-			inputChannel <- cmn.KeyValuePair[T_Key, T_Value]{Key: k, Value: v}
+		// skip keys deleted since the snapshot was taken
+		value, ok := (*x.Input)[key]
+		if ok {
+			return cmn.KeyValuePair[T_Key, *T_Value]{Key: key, Value: &value}, true
 		}
-		close(inputChannel)
-	}()
+	}
+
+	return cmn.KeyValuePair[T_Key, *T_Value]{}, false
+}
+
+func mapKeys[T_Key comparable, T_Value any](input *map[T_Key]T_Value) []T_Key {
+	keys := make([]T_Key, 0, len(*input))
+	for k := range *input {
+		keys = append(keys, k)
+	}
+	return keys
+}
 
-	return &itrFromChan[cmn.KeyValuePair[T_Key, T_Value]]{
-		InputChannel: inputChannel,
+//Map to Itr
+type iteratorFromMap[T_Key comparable, T_Value any] struct {
+	Input *map[T_Key]T_Value
+}
+
+func (x *iteratorFromMap[T_Key, T_Value]) initItr() itr[cmn.KeyValuePair[T_Key, T_Value]] {
+	return &itrFromMapKeys[T_Key, T_Value]{
+		Input: x.Input,
+		Keys:  mapKeys(x.Input),
 	}
 }
 
@@ -82,28 +132,47 @@ type ptrIteratorFromMap[T_Key comparable, T_Value any] struct {
 }
 
 func (x *ptrIteratorFromMap[T_Key, T_Value]) initItr() itr[cmn.KeyValuePair[T_Key, *T_Value]] {
-	inputChannel := make(chan cmn.KeyValuePair[T_Key, *T_Value])
-
-	go func() {
-		for k, v := range *(x.Input) {
-			inputChannel <- cmn.KeyValuePair[T_Key, *T_Value]{Key: k, Value: &v}
-		}
-		close(inputChannel)
-	}()
-
-	return &itrFromChan[cmn.KeyValuePair[T_Key, *T_Value]]{
-		InputChannel: inputChannel,
+	return &ptrItrFromMapKeys[T_Key, T_Value]{
+		Input: x.Input,
+		Keys:  mapKeys(x.Input),
 	}
 }
 
 //Chan to Itr
 type itrFromChan[T any] struct {
-	InputChannel chan T
+	InputChannel <-chan T
+	Ctx          context.Context
 }
 
 func (x *itrFromChan[T]) Next() (T, bool) {
-	result, ok := <-x.InputChannel
-	return result, ok
+	var none T
+	if x.Ctx.Err() != nil {
+		return none, false
+	}
+
+	select {
+	case result, ok := <-x.InputChannel:
+		return result, ok
+	case <-x.Ctx.Done():
+		return none, false
+	}
+}
+
+type iteratorFromChan[T any] struct {
+	InputChannel <-chan T
+	Ctx          context.Context
+	consumed     atomic.Bool
+}
+
+func (x *iteratorFromChan[T]) initItr() itr[T] {
+	if x.consumed.Swap(true) {
+		panic(cmn.ErrAlreadyEnumerated)
+	}
+
+	return &itrFromChan[T]{
+		InputChannel: x.InputChannel,
+		Ctx:          x.Ctx,
+	}
 }
 
 //Public
@@ -125,8 +194,41 @@ func FromMap[T_Key comparable, T_Value any](input *map[T_Key]T_Value) Iterator[c
 	}
 }
 
+// PointersFromMap yields a pointer to a copy of each value, as map values
+// cannot be addressed. Use UpdateValues to change the values held in the map.
 func PointersFromMap[T_Key comparable, T_Value any](input *map[T_Key]T_Value) Iterator[cmn.KeyValuePair[T_Key, *T_Value]] {
 	return &ptrIteratorFromMap[T_Key, T_Value]{
 		Input: input,
 	}
 }
+
+// FromChannel reads items from input until it is closed. A channel can only be
+// drained once, so the result is single-use: enumerating it a second time
+// panics with cmn.ErrAlreadyEnumerated.
+func FromChannel[T any](input <-chan T) Iterator[T] {
+	return FromChannelContext(context.Background(), input)
+}
+
+// FromChannelContext is FromChannel, but also stops when ctx is done.
+func FromChannelContext[T any](ctx context.Context, input <-chan T) Iterator[T] {
+	return &iteratorFromChan[T]{
+		InputChannel: input,
+		Ctx:          ctx,
+	}
+}
+
+// UpdateValues applies mutate to every value of input whose entry matches
+// predicate, writing each result back into the map. It returns the number
+// of values updated.
+func UpdateValues[T_Key comparable, T_Value any](input *map[T_Key]T_Value, predicate func(cmn.KeyValuePair[T_Key, T_Value]) bool, mutate func(*T_Value)) int {
+	// collect matches first, so the map is not written while it is being read
+	matches := ToSlice(Where(FromMap(input), predicate))
+
+	for _, kvp := range matches {
+		value := kvp.Value
+		mutate(&value)
+		(*input)[kvp.Key] = value
+	}
+
+	return len(matches)
+}
@@ -0,0 +1,127 @@
+package iterators
+
+import (
+	"io/fs"
+	"path"
+
+	cmn "github.com/alexmacinnes/golinq/common"
+)
+
+type itrFromFS struct {
+	FS      fs.FS
+	Options cmn.FSOptions
+	Errors  *sourceError
+	pending []cmn.FSEntry
+	last    *cmn.FSEntry
+}
+
+func (x *itrFromFS) Next() (cmn.FSEntry, bool) {
+	for {
+		// the entry yielded last time is only expanded now, so that a
+		// consumer that stops early never reads its children, and
+		// SkipDir can depend on what the consumer has seen
+		if x.last != nil {
+			err := x.expand(*x.last)
+			x.last = nil
+			if err != nil {
+				x.pending = nil
+				x.Errors.setErr(err)
+			}
+		}
+
+		if len(x.pending) == 0 {
+			return cmn.FSEntry{}, false
+		}
+
+		next := x.pending[len(x.pending)-1]
+		x.pending = x.pending[:len(x.pending)-1]
+		x.last = &next
+
+		if x.Options.Pattern != "" {
+			match, _ := path.Match(x.Options.Pattern, next.Entry.Name())
+			if !match {
+				continue
+			}
+		}
+		return next, true
+	}
+}
+
+// expand queues the children of a directory, so that they are read only
+// once the walk reaches them
+func (x *itrFromFS) expand(entry cmn.FSEntry) error {
+	if !entry.Entry.IsDir() {
+		return nil
+	}
+	if x.Options.MaxDepth > 0 && entry.Depth >= x.Options.MaxDepth {
+		return nil
+	}
+	if x.Options.SkipDir != nil && x.Options.SkipDir(entry) {
+		return nil
+	}
+
+	children, err := fs.ReadDir(x.FS, entry.Path)
+	if err != nil {
+		return err
+	}
+
+	// push in reverse so that children are popped in lexical order
+	for i := len(children) - 1; i >= 0; i-- {
+		x.pending = append(x.pending, cmn.FSEntry{
+			Path:  path.Join(entry.Path, children[i].Name()),
+			Entry: children[i],
+			Depth: entry.Depth + 1,
+		})
+	}
+	return nil
+}
+
+type iteratorFromFS struct {
+	sourceError
+	FS      fs.FS
+	Root    string
+	Options cmn.FSOptions
+}
+
+func (x *iteratorFromFS) initItr() itr[cmn.FSEntry] {
+	result := &itrFromFS{
+		FS:      x.FS,
+		Options: x.Options,
+		Errors:  &x.sourceError,
+	}
+	x.setErr(nil)
+
+	_, err := path.Match(x.Options.Pattern, "")
+	if err != nil {
+		x.setErr(err)
+		return result
+	}
+
+	info, err := fs.Stat(x.FS, x.Root)
+	if err != nil {
+		x.setErr(err)
+		return result
+	}
+
+	result.pending = []cmn.FSEntry{
+		{Path: x.Root, Entry: fs.FileInfoToDirEntry(info), Depth: 0},
+	}
+	return result
+}
+
+// FromFS lazily walks the tree at root in lexical order, yielding root
+// itself first, as fs.WalkDir does. Directories are only read once the
+// walk reaches them. The walk stops at the first error, which is then
+// reported by the returned error func.
+func FromFS(fsys fs.FS, root string) (Iterator[cmn.FSEntry], func() error) {
+	return FromFSWithOptions(fsys, root, cmn.FSOptions{})
+}
+
+func FromFSWithOptions(fsys fs.FS, root string, options cmn.FSOptions) (Iterator[cmn.FSEntry], func() error) {
+	result := &iteratorFromFS{
+		FS:      fsys,
+		Root:    root,
+		Options: options,
+	}
+	return result, result.Err
+}
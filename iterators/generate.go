@@ -0,0 +1,150 @@
+package iterators
+
+// Range
+type itrRange struct {
+	next      int
+	remaining int
+}
+
+func (x *itrRange) Next() (int, bool) {
+	if x.remaining <= 0 {
+		return 0, false
+	}
+	result := x.next
+	x.next++
+	x.remaining--
+	return result, true
+}
+
+type iteratorRange struct {
+	Start int
+	Count int
+}
+
+func (x *iteratorRange) initItr() itr[int] {
+	return &itrRange{
+		next:      x.Start,
+		remaining: x.Count,
+	}
+}
+
+// Repeat
+type itrRepeat[T any] struct {
+	Value     T
+	remaining int
+}
+
+func (x *itrRepeat[T]) Next() (T, bool) {
+	if x.remaining <= 0 {
+		var none T
+		return none, false
+	}
+	x.remaining--
+	return x.Value, true
+}
+
+type iteratorRepeat[T any] struct {
+	Value T
+	Count int
+}
+
+func (x *iteratorRepeat[T]) initItr() itr[T] {
+	return &itrRepeat[T]{
+		Value:     x.Value,
+		remaining: x.Count,
+	}
+}
+
+// Generate
+type itrGenerate[T any] struct {
+	NextFunc func(T) T
+	current  T
+	started  bool
+}
+
+func (x *itrGenerate[T]) Next() (T, bool) {
+	if x.started {
+		x.current = x.NextFunc(x.current)
+	}
+	x.started = true
+	return x.current, true
+}
+
+type iteratorGenerate[T any] struct {
+	Seed     T
+	NextFunc func(T) T
+}
+
+func (x *iteratorGenerate[T]) initItr() itr[T] {
+	return &itrGenerate[T]{
+		NextFunc: x.NextFunc,
+		current:  x.Seed,
+	}
+}
+
+// Unfold
+type itrUnfold[T any, S any] struct {
+	Generator func(S) (T, S, bool)
+	state     S
+	finished  bool
+}
+
+func (x *itrUnfold[T, S]) Next() (T, bool) {
+	var none T
+	if x.finished {
+		return none, false
+	}
+
+	result, state, ok := x.Generator(x.state)
+	if !ok {
+		x.finished = true
+		return none, false
+	}
+	x.state = state
+	return result, true
+}
+
+type iteratorUnfold[T any, S any] struct {
+	Seed      S
+	Generator func(S) (T, S, bool)
+}
+
+func (x *iteratorUnfold[T, S]) initItr() itr[T] {
+	return &itrUnfold[T, S]{
+		Generator: x.Generator,
+		state:     x.Seed,
+	}
+}
+
+// Public
+func Range(start int, count int) Iterator[int] {
+	return &iteratorRange{
+		Start: start,
+		Count: count,
+	}
+}
+
+func Repeat[T any](value T, count int) Iterator[T] {
+	return &iteratorRepeat[T]{
+		Value: value,
+		Count: count,
+	}
+}
+
+// Generate yields seed, next(seed), next(next(seed)), ... without end.
+// Bound it with an early-terminating operation such as First or ElementAt.
+func Generate[T any](seed T, next func(T) T) Iterator[T] {
+	return &iteratorGenerate[T]{
+		Seed:     seed,
+		NextFunc: next,
+	}
+}
+
+// Unfold calls generator with the current state, yielding its item and
+// carrying its new state forward, until generator returns false.
+func Unfold[T any, S any](seed S, generator func(S) (T, S, bool)) Iterator[T] {
+	return &iteratorUnfold[T, S]{
+		Seed:      seed,
+		Generator: generator,
+	}
+}
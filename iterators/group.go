@@ -0,0 +1,83 @@
+package iterators
+
+import cmn "github.com/alexmacinnes/golinq/common"
+
+type itrGroupBy[T_In any, T_Key comparable, T_Element any] struct {
+	Inner       itr[T_In]
+	KeyFunc     func(T_In) T_Key
+	ElementFunc func(T_In) T_Element
+	groups      []cmn.Grouping[T_Key, T_Element]
+	grouped     bool
+}
+
+func (x *itrGroupBy[T_In, T_Key, T_Element]) Next() (cmn.Grouping[T_Key, T_Element], bool) {
+	if !x.grouped {
+		x.grouped = true
+		positions := make(map[T_Key]int)
+		for _, item := range drainItr(x.Inner) {
+			key := x.KeyFunc(item)
+			i, exists := positions[key]
+			if !exists {
+				i = len(x.groups)
+				positions[key] = i
+				x.groups = append(x.groups, cmn.Grouping[T_Key, T_Element]{Key: key})
+			}
+			x.groups[i].Items = append(x.groups[i].Items, x.ElementFunc(item))
+		}
+	}
+
+	if len(x.groups) == 0 {
+		return cmn.Grouping[T_Key, T_Element]{}, false
+	}
+
+	result := x.groups[0]
+	x.groups = x.groups[1:]
+	return result, true
+}
+
+func (x *itrGroupBy[T_In, T_Key, T_Element]) Close() {
+	closeItr(x.Inner)
+}
+
+type iteratorGroupBy[T_In any, T_Key comparable, T_Element any] struct {
+	Inner       Iterator[T_In]
+	KeyFunc     func(T_In) T_Key
+	ElementFunc func(T_In) T_Element
+}
+
+func (x *iteratorGroupBy[T_In, T_Key, T_Element]) initItr() itr[cmn.Grouping[T_Key, T_Element]] {
+	return &itrGroupBy[T_In, T_Key, T_Element]{
+		Inner:       x.Inner.initItr(),
+		KeyFunc:     x.KeyFunc,
+		ElementFunc: x.ElementFunc,
+	}
+}
+
+// GroupBy reads all of inner, then yields one Grouping per key in the order
+// each key was first seen. Query a group's items with FromGrouping.
+func GroupBy[T any, T_Key comparable](inner Iterator[T], keyFunc func(T) T_Key) Iterator[cmn.Grouping[T_Key, T]] {
+	return GroupByElement(inner, keyFunc, func(x T) T { return x })
+}
+
+// GroupByElement is GroupBy, collecting elementFunc of each item rather than
+// the item itself.
+func GroupByElement[T_In any, T_Key comparable, T_Element any](inner Iterator[T_In], keyFunc func(T_In) T_Key, elementFunc func(T_In) T_Element) Iterator[cmn.Grouping[T_Key, T_Element]] {
+	return &iteratorGroupBy[T_In, T_Key, T_Element]{
+		Inner:       inner,
+		KeyFunc:     keyFunc,
+		ElementFunc: elementFunc,
+	}
+}
+
+// GroupByResult is GroupByElement, reducing each group to a single value
+// through result.
+func GroupByResult[T_In any, T_Key comparable, T_Element any, T_Out any](inner Iterator[T_In], keyFunc func(T_In) T_Key, elementFunc func(T_In) T_Element, result func(T_Key, Iterator[T_Element]) T_Out) Iterator[T_Out] {
+	return Select(GroupByElement(inner, keyFunc, elementFunc), func(g cmn.Grouping[T_Key, T_Element]) T_Out {
+		return result(g.Key, FromGrouping(g))
+	})
+}
+
+// FromGrouping yields the items of a group produced by GroupBy.
+func FromGrouping[T_Key comparable, T_Value any](input cmn.Grouping[T_Key, T_Value]) Iterator[T_Value] {
+	return FromSlice(&input.Items)
+}
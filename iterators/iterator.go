@@ -7,3 +7,43 @@ type itr[T any] interface {
 type Iterator[T any] interface {
 	initItr() itr[T]
 }
+
+// itrCloser is implemented by itrs that hold a resource, or wrap an itr that
+// does, which must be released if enumeration stops before the end.
+type itrCloser interface {
+	Close()
+}
+
+func closeItr[T any](x itr[T]) {
+	if closer, ok := x.(itrCloser); ok {
+		closer.Close()
+	}
+}
+
+// drainItr reads everything left in x, then closes it.
+func drainItr[T any](x itr[T]) []T {
+	defer closeItr(x)
+
+	result := []T{}
+	for {
+		next, ok := x.Next()
+		if !ok {
+			return result
+		}
+		result = append(result, next)
+	}
+}
+
+// sourceError records the error that ended reading from a fallible source.
+// Sources hand out its Err method as their companion error value.
+type sourceError struct {
+	err error
+}
+
+func (x *sourceError) setErr(err error) {
+	x.err = err
+}
+
+func (x *sourceError) Err() error {
+	return x.err
+}
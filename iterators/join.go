@@ -0,0 +1,166 @@
+package iterators
+
+// itrHashJoin reads Inner into a hash table on the first call to Next, then
+// streams Outer, handing each outer item and its matches to Combine. When
+// Unmatched is set, the inner items no outer item matched follow at the end.
+type itrHashJoin[T_Outer any, T_Inner any, T_Key comparable, T_Out any] struct {
+	Outer      itr[T_Outer]
+	Inner      Iterator[T_Inner]
+	OuterKey   func(T_Outer) T_Key
+	InnerKey   func(T_Inner) T_Key
+	Combine    func(T_Outer, []T_Inner) []T_Out
+	Unmatched  func(T_Inner) T_Out
+	lookup     map[T_Key][]T_Inner
+	innerItems []T_Inner
+	matched    map[T_Key]bool
+	pending    []T_Out
+	outerDone  bool
+}
+
+func (x *itrHashJoin[T_Outer, T_Inner, T_Key, T_Out]) Next() (T_Out, bool) {
+	if x.lookup == nil {
+		x.lookup = make(map[T_Key][]T_Inner)
+		x.matched = make(map[T_Key]bool)
+		x.innerItems = drainItr(x.Inner.initItr())
+		for _, item := range x.innerItems {
+			key := x.InnerKey(item)
+			x.lookup[key] = append(x.lookup[key], item)
+		}
+	}
+
+	for len(x.pending) == 0 {
+		if x.outerDone {
+			if x.Unmatched == nil || len(x.innerItems) == 0 {
+				var none T_Out
+				return none, false
+			}
+
+			item := x.innerItems[0]
+			x.innerItems = x.innerItems[1:]
+			if !x.matched[x.InnerKey(item)] {
+				return x.Unmatched(item), true
+			}
+			continue
+		}
+
+		next, ok := x.Outer.Next()
+		if !ok {
+			x.outerDone = true
+			continue
+		}
+
+		key := x.OuterKey(next)
+		x.matched[key] = true
+		x.pending = x.Combine(next, x.lookup[key])
+	}
+
+	result := x.pending[0]
+	x.pending = x.pending[1:]
+	return result, true
+}
+
+func (x *itrHashJoin[T_Outer, T_Inner, T_Key, T_Out]) Close() {
+	closeItr(x.Outer)
+	if x.lookup == nil {
+		closeItr(x.Inner.initItr())
+	}
+}
+
+type iteratorHashJoin[T_Outer any, T_Inner any, T_Key comparable, T_Out any] struct {
+	Outer     Iterator[T_Outer]
+	Inner     Iterator[T_Inner]
+	OuterKey  func(T_Outer) T_Key
+	InnerKey  func(T_Inner) T_Key
+	Combine   func(T_Outer, []T_Inner) []T_Out
+	Unmatched func(T_Inner) T_Out
+}
+
+func (x *iteratorHashJoin[T_Outer, T_Inner, T_Key, T_Out]) initItr() itr[T_Out] {
+	return &itrHashJoin[T_Outer, T_Inner, T_Key, T_Out]{
+		Outer:     x.Outer.initItr(),
+		Inner:     x.Inner,
+		OuterKey:  x.OuterKey,
+		InnerKey:  x.InnerKey,
+		Combine:   x.Combine,
+		Unmatched: x.Unmatched,
+	}
+}
+
+// Join pairs each item of outer with every item of inner that has the same
+// key, in outer order. Outer items with no match are dropped.
+func Join[T_Outer any, T_Inner any, T_Key comparable, T_Out any](outer Iterator[T_Outer], inner Iterator[T_Inner], outerKey func(T_Outer) T_Key, innerKey func(T_Inner) T_Key, result func(T_Outer, T_Inner) T_Out) Iterator[T_Out] {
+	return &iteratorHashJoin[T_Outer, T_Inner, T_Key, T_Out]{
+		Outer:    outer,
+		Inner:    inner,
+		OuterKey: outerKey,
+		InnerKey: innerKey,
+		Combine: func(o T_Outer, matches []T_Inner) []T_Out {
+			results := make([]T_Out, len(matches))
+			for i, m := range matches {
+				results[i] = result(o, m)
+			}
+			return results
+		},
+	}
+}
+
+// GroupJoin passes each item of outer to result together with all of its
+// matching inner items, which may be none.
+func GroupJoin[T_Outer any, T_Inner any, T_Key comparable, T_Out any](outer Iterator[T_Outer], inner Iterator[T_Inner], outerKey func(T_Outer) T_Key, innerKey func(T_Inner) T_Key, result func(T_Outer, Iterator[T_Inner]) T_Out) Iterator[T_Out] {
+	return &iteratorHashJoin[T_Outer, T_Inner, T_Key, T_Out]{
+		Outer:    outer,
+		Inner:    inner,
+		OuterKey: outerKey,
+		InnerKey: innerKey,
+		Combine: func(o T_Outer, matches []T_Inner) []T_Out {
+			return []T_Out{result(o, FromSlice(&matches))}
+		},
+	}
+}
+
+// LeftJoin is Join, but an outer item with no match is still passed to
+// result once, with a nil inner item.
+func LeftJoin[T_Outer any, T_Inner any, T_Key comparable, T_Out any](outer Iterator[T_Outer], inner Iterator[T_Inner], outerKey func(T_Outer) T_Key, innerKey func(T_Inner) T_Key, result func(T_Outer, *T_Inner) T_Out) Iterator[T_Out] {
+	return &iteratorHashJoin[T_Outer, T_Inner, T_Key, T_Out]{
+		Outer:    outer,
+		Inner:    inner,
+		OuterKey: outerKey,
+		InnerKey: innerKey,
+		Combine: func(o T_Outer, matches []T_Inner) []T_Out {
+			if len(matches) == 0 {
+				return []T_Out{result(o, nil)}
+			}
+			results := make([]T_Out, len(matches))
+			for i, m := range matches {
+				m := m
+				results[i] = result(o, &m)
+			}
+			return results
+		},
+	}
+}
+
+// FullOuterJoin is LeftJoin, followed by the inner items that matched no
+// outer item, each passed to result with a nil outer item.
+func FullOuterJoin[T_Outer any, T_Inner any, T_Key comparable, T_Out any](outer Iterator[T_Outer], inner Iterator[T_Inner], outerKey func(T_Outer) T_Key, innerKey func(T_Inner) T_Key, result func(*T_Outer, *T_Inner) T_Out) Iterator[T_Out] {
+	return &iteratorHashJoin[T_Outer, T_Inner, T_Key, T_Out]{
+		Outer:    outer,
+		Inner:    inner,
+		OuterKey: outerKey,
+		InnerKey: innerKey,
+		Combine: func(o T_Outer, matches []T_Inner) []T_Out {
+			if len(matches) == 0 {
+				return []T_Out{result(&o, nil)}
+			}
+			results := make([]T_Out, len(matches))
+			for i, m := range matches {
+				m := m
+				results[i] = result(&o, &m)
+			}
+			return results
+		},
+		Unmatched: func(i T_Inner) T_Out {
+			return result(nil, &i)
+		},
+	}
+}
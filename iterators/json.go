@@ -0,0 +1,118 @@
+package iterators
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"sync/atomic"
+
+	cmn "github.com/alexmacinnes/golinq/common"
+)
+
+type itrFromJSON[T any] struct {
+	Decoder *json.Decoder
+	IsArray bool
+	Errors  *sourceError
+	index   int
+	offset  int64
+	started bool
+	done    bool
+}
+
+func (x *itrFromJSON[T]) Next() (T, bool) {
+	var none T
+	if x.done {
+		return none, false
+	}
+
+	x.offset = x.Decoder.InputOffset()
+	if x.IsArray && !x.started {
+		x.started = true
+		token, err := x.Decoder.Token()
+		if err == nil && token != json.Delim('[') {
+			err = fmt.Errorf("expected start of array, found %v", token)
+		}
+		if err != nil {
+			x.finish(err)
+			return none, false
+		}
+	}
+
+	if x.IsArray && !x.Decoder.More() {
+		_, err := x.Decoder.Token() // closing bracket
+		x.finish(err)
+		return none, false
+	}
+
+	var result T
+	err := x.Decoder.Decode(&result)
+	if err == io.EOF && !x.IsArray {
+		x.done = true
+		return none, false
+	}
+	if err != nil {
+		x.finish(err)
+		return none, false
+	}
+
+	x.index++
+	return result, true
+}
+
+func (x *itrFromJSON[T]) finish(err error) {
+	x.done = true
+	if err == nil {
+		return
+	}
+
+	if err == io.EOF {
+		err = io.ErrUnexpectedEOF
+	}
+	offset := x.offset
+	var syntaxErr *json.SyntaxError
+	if errors.As(err, &syntaxErr) {
+		offset = syntaxErr.Offset
+	}
+	x.Errors.setErr(&cmn.DecodeError{Index: x.index, Offset: offset, Err: err})
+}
+
+type iteratorFromJSON[T any] struct {
+	sourceError
+	Reader   io.Reader
+	IsArray  bool
+	consumed atomic.Bool
+}
+
+func (x *iteratorFromJSON[T]) initItr() itr[T] {
+	if x.consumed.Swap(true) {
+		panic(cmn.ErrAlreadyEnumerated)
+	}
+
+	return &itrFromJSON[T]{
+		Decoder: json.NewDecoder(x.Reader),
+		IsArray: x.IsArray,
+		Errors:  &x.sourceError,
+	}
+}
+
+// FromJSONArray decodes the elements of a JSON array one at a time, so the
+// whole document is never held in memory. Decoding stops at the first bad
+// element, and the returned error func then reports a *cmn.DecodeError.
+// The reader can only be read once, so the result is single-use.
+func FromJSONArray[T any](reader io.Reader) (Iterator[T], func() error) {
+	result := &iteratorFromJSON[T]{
+		Reader:  reader,
+		IsArray: true,
+	}
+	return result, result.Err
+}
+
+// FromNDJSON decodes a stream of newline-delimited JSON values one at a time.
+// Errors are reported as for FromJSONArray.
+func FromNDJSON[T any](reader io.Reader) (Iterator[T], func() error) {
+	result := &iteratorFromJSON[T]{
+		Reader: reader,
+	}
+	return result, result.Err
+}
@@ -0,0 +1,53 @@
+package iterators
+
+// Lookup is a read-only, one-to-many index built by ToLookup. Keys keep
+// the order in which they were first seen.
+type Lookup[T_Key comparable, T_Value any] struct {
+	keys   []T_Key
+	values map[T_Key][]T_Value
+}
+
+// Get yields the values stored under key, which is empty for a missing key.
+func (x *Lookup[T_Key, T_Value]) Get(key T_Key) Iterator[T_Value] {
+	values := x.values[key]
+	return FromSlice(&values)
+}
+
+func (x *Lookup[T_Key, T_Value]) Contains(key T_Key) bool {
+	_, exists := x.values[key]
+	return exists
+}
+
+// Count is the number of distinct keys.
+func (x *Lookup[T_Key, T_Value]) Count() int {
+	return len(x.keys)
+}
+
+func (x *Lookup[T_Key, T_Value]) Keys() Iterator[T_Key] {
+	return FromSlice(&x.keys)
+}
+
+func ToLookup[T_In any, T_OutKey comparable, T_OutValue any](src Iterator[T_In], keyFunc func(T_In) T_OutKey, valueFunc func(T_In) T_OutValue) *Lookup[T_OutKey, T_OutValue] {
+	itr := src.initItr()
+	defer closeItr(itr)
+
+	result := &Lookup[T_OutKey, T_OutValue]{
+		values: map[T_OutKey][]T_OutValue{},
+	}
+
+	for {
+		next, ok := itr.Next()
+		if !ok {
+			break
+		}
+
+		key := keyFunc(next)
+		values, exists := result.values[key]
+		if !exists {
+			result.keys = append(result.keys, key)
+		}
+		result.values[key] = append(values, valueFunc(next))
+	}
+
+	return result
+}
@@ -4,6 +4,7 @@ import cmn "github.com/alexmacinnes/golinq/common"
 
 func Max[T cmn.Ordered](src Iterator[T]) (T, bool) {
 	itr := src.initItr()
+	defer closeItr(itr)
 
 	max, ok := itr.Next()
 	if !ok {
@@ -23,6 +24,7 @@ func Max[T cmn.Ordered](src Iterator[T]) (T, bool) {
 
 func Min[T cmn.Ordered](src Iterator[T]) (T, bool) {
 	itr := src.initItr()
+	defer closeItr(itr)
 
 	min, ok := itr.Next()
 	if !ok {
@@ -42,6 +44,7 @@ func Min[T cmn.Ordered](src Iterator[T]) (T, bool) {
 
 func Avg[T cmn.Numeric](src Iterator[T]) (float64, bool) {
 	itr := src.initItr()
+	defer closeItr(itr)
 
 	var total float64 = 0
 	count := 0
@@ -63,6 +66,7 @@ func Avg[T cmn.Numeric](src Iterator[T]) (float64, bool) {
 
 func Sum[T cmn.Numeric](src Iterator[T]) T {
 	itr := src.initItr()
+	defer closeItr(itr)
 
 	var total T = 0
 
@@ -77,6 +81,7 @@ func Sum[T cmn.Numeric](src Iterator[T]) T {
 
 func Count[T any](src Iterator[T]) uint32 {
 	itr := src.initItr()
+	defer closeItr(itr)
 
 	count := uint32(0)
 
@@ -91,6 +96,7 @@ func Count[T any](src Iterator[T]) uint32 {
 
 func Accumulate[TAccumulate any, TItem any](src Iterator[TItem], seed TAccumulate, accumulator func(TAccumulate, TItem) TAccumulate) TAccumulate {
 	itr := src.initItr()
+	defer closeItr(itr)
 
 	result := seed
 
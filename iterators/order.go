@@ -0,0 +1,192 @@
+package iterators
+
+import (
+	"sort"
+
+	cmn "github.com/alexmacinnes/golinq/common"
+)
+
+// OrderedIterator is the Iterator returned by OrderBy and ThenBy. OrderBy
+// returns it as a plain Iterator so that it chains into other operators;
+// ThenBy and First recover it with a type assertion.
+type OrderedIterator[T any] interface {
+	Iterator[T]
+	thenBy(key orderKey[T]) Iterator[T]
+	first() (T, bool)
+}
+
+// orderKey is one level of an ordering. Given the items to order, it
+// returns a less function over their positions, computing any keys it
+// needs once up front.
+type orderKey[T any] func(items []T) func(i, j int) bool
+
+func keyOrder[T any, T_Key cmn.Ordered](keyFunc func(T) T_Key, descending bool) orderKey[T] {
+	return func(items []T) func(i, j int) bool {
+		keys := make([]T_Key, len(items))
+		for i, x := range items {
+			keys[i] = keyFunc(x)
+		}
+
+		if descending {
+			return func(i, j int) bool { return keys[j] < keys[i] }
+		}
+		return func(i, j int) bool { return keys[i] < keys[j] }
+	}
+}
+
+func funcOrder[T any](less func(T, T) bool) orderKey[T] {
+	return func(items []T) func(i, j int) bool {
+		return func(i, j int) bool { return less(items[i], items[j]) }
+	}
+}
+
+type itrOrdered[T any] struct {
+	Inner  itr[T]
+	Sort   func([]T) []T
+	items  []T
+	sorted bool
+}
+
+func (x *itrOrdered[T]) Next() (T, bool) {
+	if !x.sorted {
+		x.sorted = true
+		x.items = x.Sort(drainItr(x.Inner))
+	}
+
+	if len(x.items) == 0 {
+		var none T
+		return none, false
+	}
+
+	result := x.items[0]
+	x.items = x.items[1:]
+	return result, true
+}
+
+func (x *itrOrdered[T]) Close() {
+	closeItr(x.Inner)
+}
+
+type iteratorOrdered[T any] struct {
+	Inner Iterator[T]
+	Keys  []orderKey[T]
+}
+
+// less orders positions in items by the first key that tells them apart.
+func (x *iteratorOrdered[T]) less(items []T) func(i, j int) bool {
+	levels := make([]func(i, j int) bool, len(x.Keys))
+	for n, key := range x.Keys {
+		levels[n] = key(items)
+	}
+
+	return func(i, j int) bool {
+		for _, less := range levels {
+			if less(i, j) {
+				return true
+			}
+			if less(j, i) {
+				return false
+			}
+		}
+		return false
+	}
+}
+
+func (x *iteratorOrdered[T]) sort(items []T) []T {
+	less := x.less(items)
+
+	positions := make([]int, len(items))
+	for i := range positions {
+		positions[i] = i
+	}
+	sort.SliceStable(positions, func(a, b int) bool { return less(positions[a], positions[b]) })
+
+	result := make([]T, len(items))
+	for i, position := range positions {
+		result[i] = items[position]
+	}
+	return result
+}
+
+func (x *iteratorOrdered[T]) initItr() itr[T] {
+	return &itrOrdered[T]{
+		Inner: x.Inner.initItr(),
+		Sort:  x.sort,
+	}
+}
+
+func (x *iteratorOrdered[T]) thenBy(key orderKey[T]) Iterator[T] {
+	keys := make([]orderKey[T], len(x.Keys), len(x.Keys)+1)
+	copy(keys, x.Keys)
+
+	return &iteratorOrdered[T]{
+		Inner: x.Inner,
+		Keys:  append(keys, key),
+	}
+}
+
+// first finds the item a full sort would put first, in a single pass.
+func (x *iteratorOrdered[T]) first() (T, bool) {
+	items := drainItr(x.Inner.initItr())
+	if len(items) == 0 {
+		var none T
+		return none, false
+	}
+
+	less := x.less(items)
+	best := 0
+	for i := 1; i < len(items); i++ {
+		if less(i, best) {
+			best = i
+		}
+	}
+	return items[best], true
+}
+
+// OrderBy sorts inner into ascending key order. The sort is stable, computes
+// each item's key once, and does not start until the result is first read.
+func OrderBy[T any, T_Key cmn.Ordered](inner Iterator[T], keyFunc func(T) T_Key) Iterator[T] {
+	return &iteratorOrdered[T]{
+		Inner: inner,
+		Keys:  []orderKey[T]{keyOrder(keyFunc, false)},
+	}
+}
+
+func OrderByDescending[T any, T_Key cmn.Ordered](inner Iterator[T], keyFunc func(T) T_Key) Iterator[T] {
+	return &iteratorOrdered[T]{
+		Inner: inner,
+		Keys:  []orderKey[T]{keyOrder(keyFunc, true)},
+	}
+}
+
+// OrderByFunc is OrderBy, ordering items by less.
+func OrderByFunc[T any](inner Iterator[T], less func(T, T) bool) Iterator[T] {
+	return &iteratorOrdered[T]{
+		Inner: inner,
+		Keys:  []orderKey[T]{funcOrder(less)},
+	}
+}
+
+// ThenBy orders the items that inner's existing keys consider equal by an
+// ascending secondary key. Inner must have come from OrderBy or ThenBy,
+// otherwise ThenBy panics with cmn.ErrNotOrdered.
+func ThenBy[T any, T_Key cmn.Ordered](inner Iterator[T], keyFunc func(T) T_Key) Iterator[T] {
+	return appendOrder(inner, keyOrder(keyFunc, false))
+}
+
+func ThenByDescending[T any, T_Key cmn.Ordered](inner Iterator[T], keyFunc func(T) T_Key) Iterator[T] {
+	return appendOrder(inner, keyOrder(keyFunc, true))
+}
+
+// ThenByFunc is ThenBy, ordering items by less.
+func ThenByFunc[T any](inner Iterator[T], less func(T, T) bool) Iterator[T] {
+	return appendOrder(inner, funcOrder(less))
+}
+
+func appendOrder[T any](inner Iterator[T], key orderKey[T]) Iterator[T] {
+	ordered, ok := inner.(OrderedIterator[T])
+	if !ok {
+		panic(cmn.ErrNotOrdered)
+	}
+	return ordered.thenBy(key)
+}
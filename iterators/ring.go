@@ -0,0 +1,65 @@
+package iterators
+
+// ringBuffer holds up to a fixed number of the most recent items, without
+// reallocating as items are added and removed.
+type ringBuffer[T any] struct {
+	items []T
+	start int
+	count int
+}
+
+func newRingBuffer[T any](capacity int) *ringBuffer[T] {
+	return &ringBuffer[T]{
+		items: make([]T, capacity),
+	}
+}
+
+func (x *ringBuffer[T]) len() int {
+	return x.count
+}
+
+func (x *ringBuffer[T]) full() bool {
+	return x.count == len(x.items)
+}
+
+// push adds item, evicting and returning the oldest item if the buffer was full
+func (x *ringBuffer[T]) push(item T) (T, bool) {
+	var evicted T
+	if len(x.items) == 0 {
+		return item, true
+	}
+
+	if x.full() {
+		evicted = x.items[x.start]
+		x.items[x.start] = item
+		x.start = (x.start + 1) % len(x.items)
+		return evicted, true
+	}
+
+	x.items[(x.start+x.count)%len(x.items)] = item
+	x.count++
+	return evicted, false
+}
+
+// pop removes and returns the oldest item
+func (x *ringBuffer[T]) pop() (T, bool) {
+	var none T
+	if x.count == 0 {
+		return none, false
+	}
+
+	result := x.items[x.start]
+	x.items[x.start] = none
+	x.start = (x.start + 1) % len(x.items)
+	x.count--
+	return result, true
+}
+
+// copyOut returns the buffered items, oldest first, in a new slice
+func (x *ringBuffer[T]) copyOut() []T {
+	result := make([]T, x.count)
+	for i := range result {
+		result[i] = x.items[(x.start+i)%len(x.items)]
+	}
+	return result
+}
@@ -0,0 +1,81 @@
+package iterators
+
+import (
+	"database/sql"
+	"sync/atomic"
+
+	cmn "github.com/alexmacinnes/golinq/common"
+)
+
+type itrFromRows[T any] struct {
+	Rows   *sql.Rows
+	Scan   func(*sql.Rows) (T, error)
+	Errors *sourceError
+	done   bool
+}
+
+func (x *itrFromRows[T]) Next() (T, bool) {
+	var none T
+	if x.done {
+		return none, false
+	}
+
+	if !x.Rows.Next() {
+		x.finish(x.Rows.Err())
+		return none, false
+	}
+
+	result, err := x.Scan(x.Rows)
+	if err != nil {
+		x.finish(err)
+		return none, false
+	}
+	return result, true
+}
+
+func (x *itrFromRows[T]) Close() {
+	if !x.done {
+		x.finish(nil)
+	}
+}
+
+func (x *itrFromRows[T]) finish(err error) {
+	x.done = true
+	closeErr := x.Rows.Close()
+	if err == nil {
+		err = closeErr
+	}
+	x.Errors.setErr(err)
+}
+
+type iteratorFromRows[T any] struct {
+	sourceError
+	Rows     *sql.Rows
+	Scan     func(*sql.Rows) (T, error)
+	consumed atomic.Bool
+}
+
+func (x *iteratorFromRows[T]) initItr() itr[T] {
+	if x.consumed.Swap(true) {
+		panic(cmn.ErrAlreadyEnumerated)
+	}
+
+	return &itrFromRows[T]{
+		Rows:   x.Rows,
+		Scan:   x.Scan,
+		Errors: &x.sourceError,
+	}
+}
+
+// FromRows yields scan applied to each row of rows. The rows are closed when
+// enumeration ends, fails, or is cut short by an operation such as First.
+// Enumeration stops at the first error, which is then reported by the
+// returned error func. Rows can only be read once, so the result is
+// single-use.
+func FromRows[T any](rows *sql.Rows, scan func(*sql.Rows) (T, error)) (Iterator[T], func() error) {
+	result := &iteratorFromRows[T]{
+		Rows: rows,
+		Scan: scan,
+	}
+	return result, result.Err
+}
@@ -0,0 +1,92 @@
+package iterators
+
+type itrScan[TAccumulate any, TItem any] struct {
+	Inner       itr[TItem]
+	Accumulator func(TAccumulate, TItem) TAccumulate
+	current     TAccumulate
+}
+
+func (x *itrScan[TAccumulate, TItem]) Next() (TAccumulate, bool) {
+	next, ok := x.Inner.Next()
+	if !ok {
+		var none TAccumulate
+		return none, false
+	}
+
+	x.current = x.Accumulator(x.current, next)
+	return x.current, true
+}
+
+func (x *itrScan[TAccumulate, TItem]) Close() {
+	closeItr(x.Inner)
+}
+
+type iteratorScan[TAccumulate any, TItem any] struct {
+	Inner       Iterator[TItem]
+	Seed        TAccumulate
+	Accumulator func(TAccumulate, TItem) TAccumulate
+}
+
+func (x *iteratorScan[TAccumulate, TItem]) initItr() itr[TAccumulate] {
+	return &itrScan[TAccumulate, TItem]{
+		Inner:       x.Inner.initItr(),
+		Accumulator: x.Accumulator,
+		current:     x.Seed,
+	}
+}
+
+// Scan is a lazy Accumulate, yielding the accumulated value after each item.
+func Scan[TAccumulate any, TItem any](inner Iterator[TItem], seed TAccumulate, accumulator func(TAccumulate, TItem) TAccumulate) Iterator[TAccumulate] {
+	return &iteratorScan[TAccumulate, TItem]{
+		Inner:       inner,
+		Seed:        seed,
+		Accumulator: accumulator,
+	}
+}
+
+type itrScanNoSeed[T any] struct {
+	Inner       itr[T]
+	Accumulator func(T, T) T
+	current     T
+	started     bool
+}
+
+func (x *itrScanNoSeed[T]) Next() (T, bool) {
+	next, ok := x.Inner.Next()
+	if !ok {
+		return next, false
+	}
+
+	if x.started {
+		x.current = x.Accumulator(x.current, next)
+	} else {
+		x.started = true
+		x.current = next
+	}
+	return x.current, true
+}
+
+func (x *itrScanNoSeed[T]) Close() {
+	closeItr(x.Inner)
+}
+
+type iteratorScanNoSeed[T any] struct {
+	Inner       Iterator[T]
+	Accumulator func(T, T) T
+}
+
+func (x *iteratorScanNoSeed[T]) initItr() itr[T] {
+	return &itrScanNoSeed[T]{
+		Inner:       x.Inner.initItr(),
+		Accumulator: x.Accumulator,
+	}
+}
+
+// ScanNoSeed is Scan, using the first item as the seed. The first item is
+// yielded unchanged.
+func ScanNoSeed[T any](inner Iterator[T], accumulator func(T, T) T) Iterator[T] {
+	return &iteratorScanNoSeed[T]{
+		Inner:       inner,
+		Accumulator: accumulator,
+	}
+}
@@ -0,0 +1,61 @@
+package iterators
+
+import (
+	"bufio"
+	"io"
+	"sync/atomic"
+
+	cmn "github.com/alexmacinnes/golinq/common"
+)
+
+type itrFromScanner struct {
+	Scanner *bufio.Scanner
+	Errors  *sourceError
+	done    bool
+}
+
+func (x *itrFromScanner) Next() (string, bool) {
+	if x.done {
+		return "", false
+	}
+
+	if x.Scanner.Scan() {
+		return x.Scanner.Text(), true
+	}
+
+	x.done = true
+	x.Errors.setErr(x.Scanner.Err())
+	return "", false
+}
+
+type iteratorFromScanner struct {
+	sourceError
+	Scanner  *bufio.Scanner
+	consumed atomic.Bool
+}
+
+func (x *iteratorFromScanner) initItr() itr[string] {
+	if x.consumed.Swap(true) {
+		panic(cmn.ErrAlreadyEnumerated)
+	}
+
+	return &itrFromScanner{
+		Scanner: x.Scanner,
+		Errors:  &x.sourceError,
+	}
+}
+
+// FromScanner yields each token produced by scanner. Reading stops at the
+// first scanner error, which is then reported by the returned error func.
+// The scanner can only be read once, so the result is single-use.
+func FromScanner(scanner *bufio.Scanner) (Iterator[string], func() error) {
+	result := &iteratorFromScanner{
+		Scanner: scanner,
+	}
+	return result, result.Err
+}
+
+// FromLines yields each line of reader, without its line ending.
+func FromLines(reader io.Reader) (Iterator[string], func() error) {
+	return FromScanner(bufio.NewScanner(reader))
+}
@@ -1,5 +1,7 @@
 package iterators
 
+import cmn "github.com/alexmacinnes/golinq/common"
+
 type itrSelect[T_In any, T_Out any] struct {
 	Inner    itr[T_In]
 	Selector func(T_In) T_Out
@@ -15,6 +17,10 @@ func (x *itrSelect[T_In, T_Out]) Next() (T_Out, bool) {
 	return x.Selector(next), true
 }
 
+func (x *itrSelect[T_In, T_Out]) Close() {
+	closeItr(x.Inner)
+}
+
 type iteratorSelect[T_In any, T_Out any] struct {
 	Inner    Iterator[T_In]
 	Selector func(T_In) T_Out
@@ -33,3 +39,52 @@ func Select[T_In any, T_Out any](inner Iterator[T_In], selector func(T_In) T_Out
 		Selector: selector,
 	}
 }
+
+type itrSelectIndexed[T_In any, T_Out any] struct {
+	Inner    itr[T_In]
+	Selector func(T_In, int) T_Out
+	index    int
+}
+
+func (x *itrSelectIndexed[T_In, T_Out]) Next() (T_Out, bool) {
+	next, ok := x.Inner.Next()
+	if !ok {
+		var none T_Out
+		return none, false
+	}
+
+	result := x.Selector(next, x.index)
+	x.index++
+	return result, true
+}
+
+func (x *itrSelectIndexed[T_In, T_Out]) Close() {
+	closeItr(x.Inner)
+}
+
+type iteratorSelectIndexed[T_In any, T_Out any] struct {
+	Inner    Iterator[T_In]
+	Selector func(T_In, int) T_Out
+}
+
+func (x *iteratorSelectIndexed[T_In, T_Out]) initItr() itr[T_Out] {
+	return &itrSelectIndexed[T_In, T_Out]{
+		Inner:    x.Inner.initItr(),
+		Selector: x.Selector,
+	}
+}
+
+// SelectIndexed is Select, but also passes the position of each item,
+// counting from zero on every enumeration.
+func SelectIndexed[T_In any, T_Out any](inner Iterator[T_In], selector func(T_In, int) T_Out) Iterator[T_Out] {
+	return &iteratorSelectIndexed[T_In, T_Out]{
+		Inner:    inner,
+		Selector: selector,
+	}
+}
+
+func WithIndex[T any](inner Iterator[T]) Iterator[cmn.Indexed[T]] {
+	return SelectIndexed(inner, func(x T, i int) cmn.Indexed[T] {
+		return cmn.Indexed[T]{Index: i, Value: x}
+	})
+}
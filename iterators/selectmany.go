@@ -0,0 +1,76 @@
+package iterators
+
+type itrSelectMany[T_In any, T_Inner any, T_Out any] struct {
+	Inner        itr[T_In]
+	Collection   func(T_In) Iterator[T_Inner]
+	Result       func(T_In, T_Inner) T_Out
+	currentOuter T_In
+	current      itr[T_Inner]
+}
+
+func (x *itrSelectMany[T_In, T_Inner, T_Out]) Next() (T_Out, bool) {
+	for {
+		if x.current != nil {
+			next, ok := x.current.Next()
+			if ok {
+				return x.Result(x.currentOuter, next), true
+			}
+			closeItr(x.current)
+			x.current = nil
+		}
+
+		outer, ok := x.Inner.Next()
+		if !ok {
+			var none T_Out
+			return none, false
+		}
+		x.currentOuter = outer
+		x.current = x.Collection(outer).initItr()
+	}
+}
+
+func (x *itrSelectMany[T_In, T_Inner, T_Out]) Close() {
+	if x.current != nil {
+		closeItr(x.current)
+	}
+	closeItr(x.Inner)
+}
+
+type iteratorSelectMany[T_In any, T_Inner any, T_Out any] struct {
+	Inner      Iterator[T_In]
+	Collection func(T_In) Iterator[T_Inner]
+	Result     func(T_In, T_Inner) T_Out
+}
+
+func (x *iteratorSelectMany[T_In, T_Inner, T_Out]) initItr() itr[T_Out] {
+	return &itrSelectMany[T_In, T_Inner, T_Out]{
+		Inner:      x.Inner.initItr(),
+		Collection: x.Collection,
+		Result:     x.Result,
+	}
+}
+
+func SelectMany[T_In any, T_Out any](inner Iterator[T_In], selector func(T_In) Iterator[T_Out]) Iterator[T_Out] {
+	return SelectManyResult(inner, selector, func(_ T_In, x T_Out) T_Out { return x })
+}
+
+func SelectManySlice[T_In any, T_Out any](inner Iterator[T_In], selector func(T_In) []T_Out) Iterator[T_Out] {
+	return SelectMany(inner, func(x T_In) Iterator[T_Out] {
+		slice := selector(x)
+		return FromSlice(&slice)
+	})
+}
+
+// SelectManyResult expands each item into a collection, then pairs the item
+// with each member of its collection through result.
+func SelectManyResult[T_In any, T_Inner any, T_Out any](inner Iterator[T_In], collection func(T_In) Iterator[T_Inner], result func(T_In, T_Inner) T_Out) Iterator[T_Out] {
+	return &iteratorSelectMany[T_In, T_Inner, T_Out]{
+		Inner:      inner,
+		Collection: collection,
+		Result:     result,
+	}
+}
+
+func Flatten[T any](inner Iterator[Iterator[T]]) Iterator[T] {
+	return SelectMany(inner, func(x Iterator[T]) Iterator[T] { return x })
+}
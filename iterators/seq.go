@@ -0,0 +1,80 @@
+//go:build go1.23
+
+package iterators
+
+import (
+	"iter"
+
+	cmn "github.com/alexmacinnes/golinq/common"
+)
+
+type itrFromSeq[T any] struct {
+	NextFunc func() (T, bool)
+	StopFunc func()
+}
+
+func (x *itrFromSeq[T]) Next() (T, bool) {
+	result, ok := x.NextFunc()
+	if !ok {
+		x.StopFunc()
+	}
+	return result, ok
+}
+
+func (x *itrFromSeq[T]) Close() {
+	x.StopFunc()
+}
+
+type iteratorFromSeq[T any] struct {
+	Seq iter.Seq[T]
+}
+
+func (x *iteratorFromSeq[T]) initItr() itr[T] {
+	next, stop := iter.Pull(x.Seq)
+	return &itrFromSeq[T]{
+		NextFunc: next,
+		StopFunc: stop,
+	}
+}
+
+func FromSeq[T any](seq iter.Seq[T]) Iterator[T] {
+	return &iteratorFromSeq[T]{
+		Seq: seq,
+	}
+}
+
+func FromSeq2[T_Key comparable, T_Value any](seq iter.Seq2[T_Key, T_Value]) Iterator[cmn.KeyValuePair[T_Key, T_Value]] {
+	return FromSeq(func(yield func(cmn.KeyValuePair[T_Key, T_Value]) bool) {
+		for k, v := range seq {
+			if !yield(cmn.KeyValuePair[T_Key, T_Value]{Key: k, Value: v}) {
+				return
+			}
+		}
+	})
+}
+
+// ToSeq runs src for a range loop. Breaking out of the loop closes src.
+func ToSeq[T any](src Iterator[T]) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		itr := src.initItr()
+		defer closeItr(itr)
+
+		for {
+			next, ok := itr.Next()
+			if !ok || !yield(next) {
+				return
+			}
+		}
+	}
+}
+
+// ToSeq2 is ToSeq for key value pairs, yielding each key and value separately.
+func ToSeq2[T_Key comparable, T_Value any](src Iterator[cmn.KeyValuePair[T_Key, T_Value]]) iter.Seq2[T_Key, T_Value] {
+	return func(yield func(T_Key, T_Value) bool) {
+		for kvp := range ToSeq(src) {
+			if !yield(kvp.Key, kvp.Value) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,242 @@
+package iterators
+
+type itrUnion[T any, T_Key comparable] struct {
+	First   itr[T]
+	Second  Iterator[T]
+	KeyFunc func(T) T_Key
+	second  itr[T]
+	seen    map[T_Key]bool
+}
+
+func (x *itrUnion[T, T_Key]) Next() (T, bool) {
+	for {
+		current := x.First
+		if x.second != nil {
+			current = x.second
+		}
+
+		next, ok := current.Next()
+		if !ok {
+			if x.second != nil {
+				return next, false
+			}
+			x.second = x.Second.initItr()
+			continue
+		}
+
+		key := x.KeyFunc(next)
+		if !x.seen[key] {
+			x.seen[key] = true
+			return next, true
+		}
+	}
+}
+
+func (x *itrUnion[T, T_Key]) Close() {
+	closeItr(x.First)
+	// second is opened just to close it if the walk never reached it,
+	// so that a source such as FromRows still releases its resource
+	if x.second == nil {
+		x.second = x.Second.initItr()
+	}
+	closeItr(x.second)
+}
+
+type iteratorUnion[T any, T_Key comparable] struct {
+	First   Iterator[T]
+	Second  Iterator[T]
+	KeyFunc func(T) T_Key
+}
+
+func (x *iteratorUnion[T, T_Key]) initItr() itr[T] {
+	return &itrUnion[T, T_Key]{
+		First:   x.First.initItr(),
+		Second:  x.Second,
+		KeyFunc: x.KeyFunc,
+		seen:    make(map[T_Key]bool),
+	}
+}
+
+// Union yields the distinct items of first, followed by the distinct items
+// of second that were not in first.
+func Union[T comparable](first Iterator[T], second Iterator[T]) Iterator[T] {
+	return UnionBy(first, second, func(x T) T { return x })
+}
+
+// UnionBy is Union, comparing items by the key returned by keyFunc.
+func UnionBy[T any, T_Key comparable](first Iterator[T], second Iterator[T], keyFunc func(T) T_Key) Iterator[T] {
+	return &iteratorUnion[T, T_Key]{
+		First:   first,
+		Second:  second,
+		KeyFunc: keyFunc,
+	}
+}
+
+// itrFilterBySet streams Inner against the keys of Second, which is read in
+// full on the first call to Next. Keep chooses between the items whose key
+// is in Second (Intersect) and those whose key is not (Except).
+type itrFilterBySet[T any, T_Key comparable] struct {
+	Inner   itr[T]
+	Second  Iterator[T]
+	KeyFunc func(T) T_Key
+	Keep    bool
+	keys    map[T_Key]bool
+}
+
+func (x *itrFilterBySet[T, T_Key]) Next() (T, bool) {
+	if x.keys == nil {
+		x.keys = make(map[T_Key]bool)
+		for _, item := range drainItr(x.Second.initItr()) {
+			x.keys[x.KeyFunc(item)] = true
+		}
+	}
+
+	for {
+		next, ok := x.Inner.Next()
+		if !ok {
+			return next, false
+		}
+
+		// each key is yielded at most once, so remove it from the
+		// intersection or add it to the exclusions once seen
+		key := x.KeyFunc(next)
+		if x.Keep && x.keys[key] {
+			delete(x.keys, key)
+			return next, true
+		}
+		if !x.Keep && !x.keys[key] {
+			x.keys[key] = true
+			return next, true
+		}
+	}
+}
+
+func (x *itrFilterBySet[T, T_Key]) Close() {
+	closeItr(x.Inner)
+	if x.keys == nil {
+		closeItr(x.Second.initItr())
+	}
+}
+
+type iteratorFilterBySet[T any, T_Key comparable] struct {
+	Inner   Iterator[T]
+	Second  Iterator[T]
+	KeyFunc func(T) T_Key
+	Keep    bool
+}
+
+func (x *iteratorFilterBySet[T, T_Key]) initItr() itr[T] {
+	return &itrFilterBySet[T, T_Key]{
+		Inner:   x.Inner.initItr(),
+		Second:  x.Second,
+		KeyFunc: x.KeyFunc,
+		Keep:    x.Keep,
+	}
+}
+
+// Intersect yields the distinct items of first that are also in second.
+func Intersect[T comparable](first Iterator[T], second Iterator[T]) Iterator[T] {
+	return IntersectBy(first, second, func(x T) T { return x })
+}
+
+// IntersectBy is Intersect, comparing items by the key returned by keyFunc.
+func IntersectBy[T any, T_Key comparable](first Iterator[T], second Iterator[T], keyFunc func(T) T_Key) Iterator[T] {
+	return &iteratorFilterBySet[T, T_Key]{
+		Inner:   first,
+		Second:  second,
+		KeyFunc: keyFunc,
+		Keep:    true,
+	}
+}
+
+// Except yields the distinct items of first that are not in second.
+func Except[T comparable](first Iterator[T], second Iterator[T]) Iterator[T] {
+	return ExceptBy(first, second, func(x T) T { return x })
+}
+
+// ExceptBy is Except, comparing items by the key returned by keyFunc.
+func ExceptBy[T any, T_Key comparable](first Iterator[T], second Iterator[T], keyFunc func(T) T_Key) Iterator[T] {
+	return &iteratorFilterBySet[T, T_Key]{
+		Inner:   first,
+		Second:  second,
+		KeyFunc: keyFunc,
+		Keep:    false,
+	}
+}
+
+type itrSymmetricDifference[T comparable] struct {
+	Inner       itr[T]
+	Second      Iterator[T]
+	secondItems []T
+	secondSet   map[T]bool
+	firstSet    map[T]bool
+	index       int
+	onSecond    bool
+}
+
+func (x *itrSymmetricDifference[T]) Next() (T, bool) {
+	if x.secondSet == nil {
+		x.secondSet = make(map[T]bool)
+		x.firstSet = make(map[T]bool)
+		for _, item := range drainItr(x.Second.initItr()) {
+			if !x.secondSet[item] {
+				x.secondSet[item] = true
+				x.secondItems = append(x.secondItems, item)
+			}
+		}
+	}
+
+	for !x.onSecond {
+		next, ok := x.Inner.Next()
+		if !ok {
+			x.onSecond = true
+			break
+		}
+
+		if !x.firstSet[next] {
+			x.firstSet[next] = true
+			if !x.secondSet[next] {
+				return next, true
+			}
+		}
+	}
+
+	for x.index < len(x.secondItems) {
+		next := x.secondItems[x.index]
+		x.index++
+		if !x.firstSet[next] {
+			return next, true
+		}
+	}
+
+	var none T
+	return none, false
+}
+
+func (x *itrSymmetricDifference[T]) Close() {
+	closeItr(x.Inner)
+	if x.secondSet == nil {
+		closeItr(x.Second.initItr())
+	}
+}
+
+type iteratorSymmetricDifference[T comparable] struct {
+	Inner  Iterator[T]
+	Second Iterator[T]
+}
+
+func (x *iteratorSymmetricDifference[T]) initItr() itr[T] {
+	return &itrSymmetricDifference[T]{
+		Inner:  x.Inner.initItr(),
+		Second: x.Second,
+	}
+}
+
+// SymmetricDifference yields the distinct items of first that are not in
+// second, followed by the distinct items of second that are not in first.
+func SymmetricDifference[T comparable](first Iterator[T], second Iterator[T]) Iterator[T] {
+	return &iteratorSymmetricDifference[T]{
+		Inner:  first,
+		Second: second,
+	}
+}
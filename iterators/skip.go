@@ -0,0 +1,138 @@
+package iterators
+
+// Skip
+type itrSkip[T any] struct {
+	Inner     itr[T]
+	remaining int
+}
+
+func (x *itrSkip[T]) Next() (T, bool) {
+	for ; x.remaining > 0; x.remaining-- {
+		next, ok := x.Inner.Next()
+		if !ok {
+			x.remaining = 0
+			return next, false
+		}
+	}
+
+	return x.Inner.Next()
+}
+
+func (x *itrSkip[T]) Close() {
+	closeItr(x.Inner)
+}
+
+type iteratorSkip[T any] struct {
+	Inner Iterator[T]
+	Count int
+}
+
+func (x *iteratorSkip[T]) initItr() itr[T] {
+	return &itrSkip[T]{
+		Inner:     x.Inner.initItr(),
+		remaining: x.Count,
+	}
+}
+
+// SkipWhile
+type itrSkipWhile[T any] struct {
+	Inner     itr[T]
+	Predicate func(T) bool
+	skipped   bool
+}
+
+func (x *itrSkipWhile[T]) Next() (T, bool) {
+	if x.skipped {
+		return x.Inner.Next()
+	}
+
+	for {
+		next, ok := x.Inner.Next()
+		if !ok || !x.Predicate(next) {
+			x.skipped = true
+			return next, ok
+		}
+	}
+}
+
+func (x *itrSkipWhile[T]) Close() {
+	closeItr(x.Inner)
+}
+
+type iteratorSkipWhile[T any] struct {
+	Inner     Iterator[T]
+	Predicate func(T) bool
+}
+
+func (x *iteratorSkipWhile[T]) initItr() itr[T] {
+	return &itrSkipWhile[T]{
+		Inner:     x.Inner.initItr(),
+		Predicate: x.Predicate,
+	}
+}
+
+// SkipLast
+type itrSkipLast[T any] struct {
+	Inner  itr[T]
+	buffer *ringBuffer[T]
+}
+
+func (x *itrSkipLast[T]) Next() (T, bool) {
+	for {
+		next, ok := x.Inner.Next()
+		if !ok {
+			return next, false
+		}
+
+		// an item is only yielded once count newer items are held back
+		evicted, full := x.buffer.push(next)
+		if full {
+			return evicted, true
+		}
+	}
+}
+
+func (x *itrSkipLast[T]) Close() {
+	closeItr(x.Inner)
+}
+
+type iteratorSkipLast[T any] struct {
+	Inner Iterator[T]
+	Count int
+}
+
+func (x *iteratorSkipLast[T]) initItr() itr[T] {
+	count := x.Count
+	if count < 0 {
+		count = 0
+	}
+
+	return &itrSkipLast[T]{
+		Inner:  x.Inner.initItr(),
+		buffer: newRingBuffer[T](count),
+	}
+}
+
+// Public
+func Skip[T any](inner Iterator[T], count int) Iterator[T] {
+	return &iteratorSkip[T]{
+		Inner: inner,
+		Count: count,
+	}
+}
+
+func SkipWhile[T any](inner Iterator[T], predicate func(T) bool) Iterator[T] {
+	return &iteratorSkipWhile[T]{
+		Inner:     inner,
+		Predicate: predicate,
+	}
+}
+
+// SkipLast yields all but the final count items, buffering no more than
+// count at once.
+func SkipLast[T any](inner Iterator[T], count int) Iterator[T] {
+	return &iteratorSkipLast[T]{
+		Inner: inner,
+		Count: count,
+	}
+}
@@ -0,0 +1,37 @@
+package iterators
+
+import (
+	"sort"
+
+	cmn "github.com/alexmacinnes/golinq/common"
+)
+
+type iteratorFromMapSorted[T_Key comparable, T_Value any] struct {
+	Input *map[T_Key]T_Value
+	Less  func(T_Key, T_Key) bool
+}
+
+func (x *iteratorFromMapSorted[T_Key, T_Value]) initItr() itr[cmn.KeyValuePair[T_Key, T_Value]] {
+	keys := mapKeys(x.Input)
+	sort.Slice(keys, func(i, j int) bool { return x.Less(keys[i], keys[j]) })
+
+	return &itrFromMapKeys[T_Key, T_Value]{
+		Input: x.Input,
+		Keys:  keys,
+	}
+}
+
+// FromMapSorted is FromMap, but yields entries in ascending key order.
+func FromMapSorted[T_Key cmn.Ordered, T_Value any](input *map[T_Key]T_Value) Iterator[cmn.KeyValuePair[T_Key, T_Value]] {
+	return FromMapSortedFunc(input, func(a T_Key, b T_Key) bool { return a < b })
+}
+
+// FromMapSortedFunc is FromMap, but yields entries in the key order defined
+// by less, which must order every pair of distinct keys for the result to
+// be deterministic.
+func FromMapSortedFunc[T_Key comparable, T_Value any](input *map[T_Key]T_Value, less func(T_Key, T_Key) bool) Iterator[cmn.KeyValuePair[T_Key, T_Value]] {
+	return &iteratorFromMapSorted[T_Key, T_Value]{
+		Input: input,
+		Less:  less,
+	}
+}
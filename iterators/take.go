@@ -0,0 +1,146 @@
+package iterators
+
+// Take
+type itrTake[T any] struct {
+	Inner     itr[T]
+	remaining int
+}
+
+func (x *itrTake[T]) Next() (T, bool) {
+	if x.remaining <= 0 {
+		var none T
+		return none, false
+	}
+
+	next, ok := x.Inner.Next()
+	x.remaining--
+	if !ok {
+		x.remaining = 0
+	} else if x.remaining == 0 {
+		closeItr(x.Inner) // satisfied, release the source straight away
+	}
+	return next, ok
+}
+
+func (x *itrTake[T]) Close() {
+	closeItr(x.Inner)
+}
+
+type iteratorTake[T any] struct {
+	Inner Iterator[T]
+	Count int
+}
+
+func (x *iteratorTake[T]) initItr() itr[T] {
+	return &itrTake[T]{
+		Inner:     x.Inner.initItr(),
+		remaining: x.Count,
+	}
+}
+
+// TakeWhile
+type itrTakeWhile[T any] struct {
+	Inner     itr[T]
+	Predicate func(T) bool
+	done      bool
+}
+
+func (x *itrTakeWhile[T]) Next() (T, bool) {
+	var none T
+	if x.done {
+		return none, false
+	}
+
+	next, ok := x.Inner.Next()
+	if ok && x.Predicate(next) {
+		return next, true
+	}
+
+	x.done = true
+	closeItr(x.Inner)
+	return none, false
+}
+
+func (x *itrTakeWhile[T]) Close() {
+	closeItr(x.Inner)
+}
+
+type iteratorTakeWhile[T any] struct {
+	Inner     Iterator[T]
+	Predicate func(T) bool
+}
+
+func (x *iteratorTakeWhile[T]) initItr() itr[T] {
+	return &itrTakeWhile[T]{
+		Inner:     x.Inner.initItr(),
+		Predicate: x.Predicate,
+	}
+}
+
+// TakeLast
+type itrTakeLast[T any] struct {
+	Inner  itr[T]
+	Count  int
+	buffer *ringBuffer[T]
+}
+
+func (x *itrTakeLast[T]) Next() (T, bool) {
+	if x.buffer == nil {
+		x.buffer = newRingBuffer[T](x.Count)
+		for {
+			next, ok := x.Inner.Next()
+			if !ok {
+				break
+			}
+			x.buffer.push(next)
+		}
+	}
+
+	return x.buffer.pop()
+}
+
+func (x *itrTakeLast[T]) Close() {
+	closeItr(x.Inner)
+}
+
+type iteratorTakeLast[T any] struct {
+	Inner Iterator[T]
+	Count int
+}
+
+func (x *iteratorTakeLast[T]) initItr() itr[T] {
+	count := x.Count
+	if count < 0 {
+		count = 0
+	}
+
+	return &itrTakeLast[T]{
+		Inner: x.Inner.initItr(),
+		Count: count,
+	}
+}
+
+// Public
+
+// Take yields the first count items, releasing inner as soon as it has them.
+func Take[T any](inner Iterator[T], count int) Iterator[T] {
+	return &iteratorTake[T]{
+		Inner: inner,
+		Count: count,
+	}
+}
+
+func TakeWhile[T any](inner Iterator[T], predicate func(T) bool) Iterator[T] {
+	return &iteratorTakeWhile[T]{
+		Inner:     inner,
+		Predicate: predicate,
+	}
+}
+
+// TakeLast yields the final count items, buffering no more than count at once.
+func TakeLast[T any](inner Iterator[T], count int) Iterator[T] {
+	return &iteratorTakeLast[T]{
+		Inner: inner,
+		Count: count,
+	}
+}
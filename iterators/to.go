@@ -2,6 +2,7 @@ package iterators
 
 func ToSlice[T_Out any](src Iterator[T_Out]) []T_Out {
 	itr := src.initItr()
+	defer closeItr(itr)
 
 	result := []T_Out{}
 	for {
@@ -17,6 +18,7 @@ func ToSlice[T_Out any](src Iterator[T_Out]) []T_Out {
 
 func ToMap[T_In any, T_OutKey comparable, T_OutValue any](src Iterator[T_In], keyFunc func(T_In) T_OutKey, valueFunc func(T_In) T_OutValue) (map[T_OutKey]T_OutValue, bool) {
 	itr := src.initItr()
+	defer closeItr(itr)
 
 	result := map[T_OutKey]T_OutValue{}
 
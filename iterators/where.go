@@ -19,6 +19,10 @@ func (x *itrWhere[T]) Next() (T, bool) {
 	}
 }
 
+func (x *itrWhere[T]) Close() {
+	closeItr(x.Inner)
+}
+
 type iteratorWhere[T any] struct {
 	Inner     Iterator[T]
 	Predicate func(T) bool
@@ -37,3 +41,49 @@ func Where[T any](inner Iterator[T], predicate func(T) bool) Iterator[T] {
 		Predicate: predicate,
 	}
 }
+
+type itrWhereIndexed[T any] struct {
+	Inner     itr[T]
+	Predicate func(T, int) bool
+	index     int
+}
+
+func (x *itrWhereIndexed[T]) Next() (T, bool) {
+	for {
+		next, ok := x.Inner.Next()
+		if !ok {
+			return next, false
+		}
+
+		match := x.Predicate(next, x.index)
+		x.index++
+		if match {
+			return next, true
+		}
+	}
+}
+
+func (x *itrWhereIndexed[T]) Close() {
+	closeItr(x.Inner)
+}
+
+type iteratorWhereIndexed[T any] struct {
+	Inner     Iterator[T]
+	Predicate func(T, int) bool
+}
+
+func (x *iteratorWhereIndexed[T]) initItr() itr[T] {
+	return &itrWhereIndexed[T]{
+		Inner:     x.Inner.initItr(),
+		Predicate: x.Predicate,
+	}
+}
+
+// WhereIndexed is Where, but also passes the position of each item in inner,
+// counting from zero on every enumeration.
+func WhereIndexed[T any](inner Iterator[T], predicate func(T, int) bool) Iterator[T] {
+	return &iteratorWhereIndexed[T]{
+		Inner:     inner,
+		Predicate: predicate,
+	}
+}
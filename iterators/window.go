@@ -0,0 +1,100 @@
+package iterators
+
+import cmn "github.com/alexmacinnes/golinq/common"
+
+type itrWindow[T any] struct {
+	Inner   itr[T]
+	Size    uint32
+	Step    uint32
+	Partial bool
+	buffer  *ringBuffer[T]
+	skip    uint32
+	done    bool
+}
+
+// advance drops the items the next window does not share with the last
+func (x *itrWindow[T]) advance() {
+	for i := uint32(0); i < x.Step && x.buffer.len() > 0; i++ {
+		x.buffer.pop()
+	}
+	if x.Step > x.Size {
+		x.skip = x.Step - x.Size
+	}
+}
+
+func (x *itrWindow[T]) Next() ([]T, bool) {
+	for !x.done {
+		next, ok := x.Inner.Next()
+		if !ok {
+			x.done = true
+			x.skip = 0
+			break
+		}
+
+		if x.skip > 0 {
+			x.skip--
+			continue
+		}
+
+		x.buffer.push(next)
+		if x.buffer.full() {
+			result := x.buffer.copyOut()
+			x.advance()
+			return result, true
+		}
+	}
+
+	if x.Partial && x.buffer.len() > 0 {
+		result := x.buffer.copyOut()
+		x.advance()
+		return result, true
+	}
+
+	var none []T
+	return none, false
+}
+
+func (x *itrWindow[T]) Close() {
+	closeItr(x.Inner)
+}
+
+type iteratorWindow[T any] struct {
+	Inner   Iterator[T]
+	Size    uint32
+	Step    uint32
+	Partial bool
+}
+
+func (x *iteratorWindow[T]) initItr() itr[[]T] {
+	return &itrWindow[T]{
+		Inner:   x.Inner.initItr(),
+		Size:    x.Size,
+		Step:    x.Step,
+		Partial: x.Partial,
+		buffer:  newRingBuffer[T](int(x.Size)),
+	}
+}
+
+// Window yields slices of size consecutive items, starting a new window
+// every step items, so windows overlap when step is less than size. When
+// partial is set, the shorter windows that start near the end are yielded
+// too. Window panics with cmn.ErrInvalidWindow if size or step is zero.
+func Window[T any](inner Iterator[T], size uint32, step uint32, partial bool) Iterator[[]T] {
+	if size == 0 || step == 0 {
+		panic(cmn.ErrInvalidWindow)
+	}
+
+	return &iteratorWindow[T]{
+		Inner:   inner,
+		Size:    size,
+		Step:    step,
+		Partial: partial,
+	}
+}
+
+// Pairwise yields each item paired with the item after it.
+func Pairwise[T any](inner Iterator[T]) Iterator[cmn.Pair[T, T]] {
+	return Select(Window(inner, 2, 1, false), func(x []T) cmn.Pair[T, T] {
+		return cmn.Pair[T, T]{First: x[0], Second: x[1]}
+	})
+}
@@ -0,0 +1,117 @@
+package iterators
+
+import cmn "github.com/alexmacinnes/golinq/common"
+
+type itrZip[T_First any, T_Second any, T_Out any] struct {
+	First         itr[T_First]
+	Second        itr[T_Second]
+	Result        func(T_First, T_Second) T_Out
+	Longest       bool
+	DefaultFirst  T_First
+	DefaultSecond T_Second
+	firstDone     bool
+	secondDone    bool
+	done          bool
+}
+
+func (x *itrZip[T_First, T_Second, T_Out]) Next() (T_Out, bool) {
+	var none T_Out
+	if x.done {
+		return none, false
+	}
+
+	first, second := x.DefaultFirst, x.DefaultSecond
+	if !x.firstDone {
+		next, ok := x.First.Next()
+		if ok {
+			first = next
+		} else {
+			x.firstDone = true
+			if !x.Longest {
+				x.done = true
+				return none, false
+			}
+		}
+	}
+	if !x.secondDone {
+		next, ok := x.Second.Next()
+		if ok {
+			second = next
+		} else {
+			x.secondDone = true
+			if !x.Longest {
+				x.done = true
+				return none, false
+			}
+		}
+	}
+
+	if x.firstDone && x.secondDone {
+		x.done = true
+		return none, false
+	}
+	return x.Result(first, second), true
+}
+
+func (x *itrZip[T_First, T_Second, T_Out]) Close() {
+	closeItr(x.First)
+	closeItr(x.Second)
+}
+
+type iteratorZip[T_First any, T_Second any, T_Out any] struct {
+	First         Iterator[T_First]
+	Second        Iterator[T_Second]
+	Result        func(T_First, T_Second) T_Out
+	Longest       bool
+	DefaultFirst  T_First
+	DefaultSecond T_Second
+}
+
+func (x *iteratorZip[T_First, T_Second, T_Out]) initItr() itr[T_Out] {
+	return &itrZip[T_First, T_Second, T_Out]{
+		First:         x.First.initItr(),
+		Second:        x.Second.initItr(),
+		Result:        x.Result,
+		Longest:       x.Longest,
+		DefaultFirst:  x.DefaultFirst,
+		DefaultSecond: x.DefaultSecond,
+	}
+}
+
+// Zip pairs up the items of first and second by position, stopping when
+// either runs out.
+func Zip[T_First any, T_Second any](first Iterator[T_First], second Iterator[T_Second]) Iterator[cmn.Pair[T_First, T_Second]] {
+	return ZipWith(first, second, func(a T_First, b T_Second) cmn.Pair[T_First, T_Second] {
+		return cmn.Pair[T_First, T_Second]{First: a, Second: b}
+	})
+}
+
+// ZipWith is Zip, combining each pair of items through result.
+func ZipWith[T_First any, T_Second any, T_Out any](first Iterator[T_First], second Iterator[T_Second], result func(T_First, T_Second) T_Out) Iterator[T_Out] {
+	return &iteratorZip[T_First, T_Second, T_Out]{
+		First:  first,
+		Second: second,
+		Result: result,
+	}
+}
+
+func Zip3[T_First any, T_Second any, T_Third any](first Iterator[T_First], second Iterator[T_Second], third Iterator[T_Third]) Iterator[cmn.Triple[T_First, T_Second, T_Third]] {
+	return ZipWith(Zip(first, second), third, func(p cmn.Pair[T_First, T_Second], c T_Third) cmn.Triple[T_First, T_Second, T_Third] {
+		return cmn.Triple[T_First, T_Second, T_Third]{First: p.First, Second: p.Second, Third: c}
+	})
+}
+
+// ZipLongest is Zip, carrying on until both sides run out. The side that
+// ends first is filled with its default value.
+func ZipLongest[T_First any, T_Second any](first Iterator[T_First], second Iterator[T_Second], defaultFirst T_First, defaultSecond T_Second) Iterator[cmn.Pair[T_First, T_Second]] {
+	return &iteratorZip[T_First, T_Second, cmn.Pair[T_First, T_Second]]{
+		First:  first,
+		Second: second,
+		Result: func(a T_First, b T_Second) cmn.Pair[T_First, T_Second] {
+			return cmn.Pair[T_First, T_Second]{First: a, Second: b}
+		},
+		Longest:       true,
+		DefaultFirst:  defaultFirst,
+		DefaultSecond: defaultSecond,
+	}
+}
@@ -0,0 +1,157 @@
+//go:build go1.23
+
+package test
+
+import (
+	"maps"
+	"runtime"
+	"slices"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	enm "github.com/alexmacinnes/golinq/enumerables"
+	itr "github.com/alexmacinnes/golinq/iterators"
+)
+
+func TestFromSeq_Enm(t *testing.T) {
+	x1 := enm.FromSeq(slices.Values(personSlice5()))
+	x2 := enm.Where(x1, func(p Person) bool { return p.Age > 30 })
+	names := enm.ToSlice(enm.Select(x2, personName))
+
+	assertResult(t, []string{"Lucy", "Zack", "Rach"}, names)
+}
+
+func TestFromSeq_Itr(t *testing.T) {
+	x1 := itr.FromSeq(slices.Values(personSlice5()))
+	x2 := itr.Where(x1, func(p Person) bool { return p.Age > 30 })
+	names := itr.ToSlice(itr.Select(x2, personName))
+
+	assertResult(t, []string{"Lucy", "Zack", "Rach"}, names)
+}
+
+func TestFromSeqEarlyExit_Enm(t *testing.T) {
+	before := runtime.NumGoroutine()
+	stopped := atomic.Bool{}
+	seq := func(yield func(int) bool) {
+		defer func() { stopped.Store(true) }()
+		for i := 0; ; i++ {
+			if !yield(i) {
+				return
+			}
+		}
+	}
+
+	element, _ := enm.ElementAt(enm.FromSeq(seq), 3)
+
+	assertResult(t, 3, element)
+	assertGoroutines(t, before)
+	assertResult(t, true, stopped.Load())
+}
+
+func TestFromSeqEarlyExit_Itr(t *testing.T) {
+	stopped := false
+	seq := func(yield func(int) bool) {
+		defer func() { stopped = true }()
+		for i := 0; ; i++ {
+			if !yield(i) {
+				return
+			}
+		}
+	}
+
+	element, _ := itr.ElementAt(itr.FromSeq(seq), 3)
+
+	assertResult(t, 3, element)
+	assertResult(t, true, stopped)
+}
+
+func TestFromSeq2_Enm(t *testing.T) {
+	x1 := enm.FromSeq2(maps.All(personMap5()))
+	ages, _ := enm.ToMap(x1, func(kvp KeyPerson) string { return kvp.Key }, func(kvp KeyPerson) int { return kvp.Value.Age })
+
+	assertResult(t, map[string]int{"James": 23, "Lucy": 33, "Zack": 41, "Abi": 19, "Rach": 33}, ages)
+}
+
+func TestFromSeq2_Itr(t *testing.T) {
+	x1 := itr.FromSeq2(maps.All(personMap5()))
+	ages, _ := itr.ToMap(x1, func(kvp KeyPerson) string { return kvp.Key }, func(kvp KeyPerson) int { return kvp.Value.Age })
+
+	assertResult(t, map[string]int{"James": 23, "Lucy": 33, "Zack": 41, "Abi": 19, "Rach": 33}, ages)
+}
+
+func TestToSeq_Enm(t *testing.T) {
+	p := personSlice5()
+	x1 := enm.Select(enm.FromSlice(&p), personName)
+
+	names := []string{}
+	for name := range enm.ToSeq(x1) {
+		names = append(names, name)
+	}
+
+	assertResult(t, []string{"James", "Lucy", "Zack", "Abi", "Rach"}, names)
+	assertResult(t, []string{"Abi", "James", "Lucy", "Rach", "Zack"}, slices.Sorted(enm.ToSeq(x1)))
+}
+
+func TestToSeq_Itr(t *testing.T) {
+	p := personSlice5()
+	x1 := itr.Select(itr.FromSlice(&p), personName)
+
+	names := []string{}
+	for name := range itr.ToSeq(x1) {
+		names = append(names, name)
+	}
+
+	assertResult(t, []string{"James", "Lucy", "Zack", "Abi", "Rach"}, names)
+	assertResult(t, []string{"Abi", "James", "Lucy", "Rach", "Zack"}, slices.Sorted(itr.ToSeq(x1)))
+}
+
+func TestToSeqBreak_Enm(t *testing.T) {
+	before := runtime.NumGoroutine()
+	x1 := enm.Generate(1, func(i int) int { return i + 1 })
+	x2 := enm.Where(x1, func(i int) bool { return i%3 == 0 })
+
+	sum := 0
+	for x := range enm.ToSeq(x2) {
+		if x > 10 {
+			break
+		}
+		sum += x
+	}
+
+	assertResult(t, 18, sum)
+	assertGoroutines(t, before)
+}
+
+func TestToSeqBreak_Itr(t *testing.T) {
+	x1 := itr.Generate(1, func(i int) int { return i + 1 })
+	x2 := itr.Where(x1, func(i int) bool { return i%3 == 0 })
+
+	sum := 0
+	for x := range itr.ToSeq(x2) {
+		if x > 10 {
+			break
+		}
+		sum += x
+	}
+
+	assertResult(t, 18, sum)
+}
+
+func TestToSeq2_Enm(t *testing.T) {
+	m := personMap5()
+	x1 := enm.Where(enm.FromMap(&m), func(kvp KeyPerson) bool { return strings.Contains(kvp.Key, "a") })
+
+	actual := maps.Collect(enm.ToSeq2(x1))
+
+	assertResult(t, map[string]Person{"James": m["James"], "Zack": m["Zack"], "Rach": m["Rach"]}, actual)
+}
+
+func TestToSeq2_Itr(t *testing.T) {
+	m := personMap5()
+	x1 := itr.Where(itr.FromMap(&m), func(kvp KeyPerson) bool { return strings.Contains(kvp.Key, "a") })
+
+	actual := maps.Collect(itr.ToSeq2(x1))
+
+	assertResult(t, map[string]Person{"James": m["James"], "Zack": m["Zack"], "Rach": m["Rach"]}, actual)
+}
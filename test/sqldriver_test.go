@@ -0,0 +1,113 @@
+package test
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"io"
+	"sync/atomic"
+)
+
+// a minimal read-only database/sql driver serving personSlice5 as the
+// table "people", which counts how many of its result sets are open
+
+var openFakeRows atomic.Int32
+
+var errFakeRows = errors.New("fake rows failed")
+
+type fakeDriver struct{}
+
+type fakeConn struct{}
+
+type fakeStmt struct {
+	query string
+}
+
+type fakeRows struct {
+	rows   [][]driver.Value
+	failAt int
+	index  int
+	closed bool
+}
+
+func init() {
+	sql.Register("golinqfake", fakeDriver{})
+}
+
+func openFakeDB() *sql.DB {
+	db, err := sql.Open("golinqfake", "")
+	if err != nil {
+		panic(err)
+	}
+	return db
+}
+
+func (fakeDriver) Open(name string) (driver.Conn, error) {
+	return &fakeConn{}, nil
+}
+
+func (c *fakeConn) Prepare(query string) (driver.Stmt, error) {
+	return &fakeStmt{query: query}, nil
+}
+
+func (c *fakeConn) Close() error {
+	return nil
+}
+
+func (c *fakeConn) Begin() (driver.Tx, error) {
+	return nil, errors.New("transactions are not supported")
+}
+
+func (s *fakeStmt) Close() error {
+	return nil
+}
+
+func (s *fakeStmt) NumInput() int {
+	return -1
+}
+
+func (s *fakeStmt) Exec(args []driver.Value) (driver.Result, error) {
+	return nil, errors.New("exec is not supported")
+}
+
+// "people" returns every person, "broken people" fails after the second row
+func (s *fakeStmt) Query(args []driver.Value) (driver.Rows, error) {
+	result := &fakeRows{failAt: -1}
+	switch s.query {
+	case "people":
+	case "broken people":
+		result.failAt = 2
+	default:
+		return nil, errors.New("unknown table: " + s.query)
+	}
+
+	for _, p := range personSlice5() {
+		result.rows = append(result.rows, []driver.Value{p.Name, int64(p.Age)})
+	}
+	openFakeRows.Add(1)
+	return result, nil
+}
+
+func (r *fakeRows) Columns() []string {
+	return []string{"name", "age"}
+}
+
+func (r *fakeRows) Close() error {
+	if !r.closed {
+		r.closed = true
+		openFakeRows.Add(-1)
+	}
+	return nil
+}
+
+func (r *fakeRows) Next(dest []driver.Value) error {
+	if r.index == r.failAt {
+		return errFakeRows
+	}
+	if r.index >= len(r.rows) {
+		return io.EOF
+	}
+	copy(dest, r.rows[r.index])
+	r.index++
+	return nil
+}
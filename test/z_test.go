@@ -5,10 +5,25 @@ import (
 	enm "github.com/alexmacinnes/golinq/enumerables"
 	itr "github.com/alexmacinnes/golinq/iterators"
 
+	"bufio"
+	"context"
+	"database/sql"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
+	"io/fs"
 	"reflect"
+	"runtime"
+	"strconv"
 	"strings"
+	"sync"
+	"sync/atomic"
 	"testing"
+	"testing/fstest"
+	"testing/iotest"
+	"time"
 )
 
 type Person struct {
@@ -36,6 +51,8 @@ func personSlice5() []Person {
 	}
 }
 
+type KeyPerson = cmn.KeyValuePair[string, Person]
+
 func personMap0() map[string]Person {
 	return map[string]Person{}
 }
@@ -55,6 +72,29 @@ func assertResult[T any](t *testing.T, expected T, actual T) {
 	}
 }
 
+func assertPanics(t *testing.T, expected any, f func()) {
+	defer func() {
+		actual := recover()
+		if actual != expected {
+			msg := fmt.Sprintf("Expected panic %v, Actual %v", expected, actual)
+			(*t).Fatal(msg)
+		}
+	}()
+	f()
+}
+
+// waits for background goroutines to wind down to at most the expected count
+func assertGoroutines(t *testing.T, expected int) {
+	deadline := time.Now().Add(time.Second)
+	for runtime.NumGoroutine() > expected {
+		if time.Now().After(deadline) {
+			msg := fmt.Sprintf("Expected at most %v goroutines, Actual %v", expected, runtime.NumGoroutine())
+			(*t).Fatal(msg)
+		}
+		time.Sleep(time.Millisecond)
+	}
+}
+
 func personAge(p Person) int { return p.Age }
 
 func personName(p Person) string { return p.Name }
@@ -67,6 +107,15 @@ func intRange(min int, max int) []int {
 	return nums
 }
 
+func intChannel(nums []int) chan int {
+	ch := make(chan int, len(nums))
+	for _, x := range nums {
+		ch <- x
+	}
+	close(ch)
+	return ch
+}
+
 func TestSelect_Enm(t *testing.T) {
 	p := personSlice1()
 
@@ -1262,7 +1311,6 @@ func TestFromMapEmpty_Itr(t *testing.T) {
 }
 
 func TestPointersFromMap_Enm(t *testing.T) {
-	//TODO - intermittent failures
 	p := personMap5()
 
 	x1 := enm.PointersFromMap(&p)
@@ -1274,7 +1322,6 @@ func TestPointersFromMap_Enm(t *testing.T) {
 }
 
 func TestPointersFromMap_Itr(t *testing.T) {
-	//TODO - intermittent failures
 	p := personMap5()
 
 	x1 := itr.PointersFromMap(&p)
@@ -1302,3 +1349,2105 @@ func TestPointersFromMapEmpty_Itr(t *testing.T) {
 
 	assertResult(t, len(slice), 0)
 }
+
+func TestFromChannel_Enm(t *testing.T) {
+	ch := intChannel(intRange(1, 10))
+
+	x1 := enm.FromChannel(ch)
+	x2 := enm.Where(x1, func(i int) bool { return i%2 == 0 })
+	x3 := enm.Select(x2, func(i int) int { return i * 10 })
+	actual := enm.ToSlice(x3)
+
+	assertResult(t, []int{20, 40, 60, 80, 100}, actual)
+}
+
+func TestFromChannel_Itr(t *testing.T) {
+	ch := intChannel(intRange(1, 10))
+
+	x1 := itr.FromChannel(ch)
+	x2 := itr.Where(x1, func(i int) bool { return i%2 == 0 })
+	x3 := itr.Select(x2, func(i int) int { return i * 10 })
+	actual := itr.ToSlice(x3)
+
+	assertResult(t, []int{20, 40, 60, 80, 100}, actual)
+}
+
+func TestFromChannelSingleUse_Enm(t *testing.T) {
+	ch := intChannel(intRange(1, 3))
+
+	x1 := enm.FromChannel(ch)
+	x2 := enm.Select(x1, func(i int) int { return i })
+	assertResult(t, []int{1, 2, 3}, enm.ToSlice(x2))
+
+	assertPanics(t, cmn.ErrAlreadyEnumerated, func() { enm.ToSlice(x2) })
+}
+
+func TestFromChannelSingleUse_Itr(t *testing.T) {
+	ch := intChannel(intRange(1, 3))
+
+	x1 := itr.FromChannel(ch)
+	x2 := itr.Select(x1, func(i int) int { return i })
+	assertResult(t, []int{1, 2, 3}, itr.ToSlice(x2))
+
+	assertPanics(t, cmn.ErrAlreadyEnumerated, func() { itr.ToSlice(x2) })
+}
+
+func TestFromChannelContext_Enm(t *testing.T) {
+	ch := make(chan int)
+	ctx, cancel := context.WithCancel(context.Background())
+	go func() {
+		for i := 1; i <= 3; i++ {
+			ch <- i
+		}
+		cancel() // channel is never closed
+	}()
+
+	x1 := enm.FromChannelContext(ctx, ch)
+	actual := enm.ToSlice(x1)
+
+	assertResult(t, []int{1, 2, 3}, actual)
+}
+
+func TestFromChannelContext_Itr(t *testing.T) {
+	ch := make(chan int)
+	ctx, cancel := context.WithCancel(context.Background())
+	go func() {
+		for i := 1; i <= 3; i++ {
+			ch <- i
+		}
+		cancel() // channel is never closed
+	}()
+
+	x1 := itr.FromChannelContext(ctx, ch)
+	actual := itr.ToSlice(x1)
+
+	assertResult(t, []int{1, 2, 3}, actual)
+}
+
+func TestRange_Enm(t *testing.T) {
+	assertResult(t, []int{3, 4, 5, 6}, enm.ToSlice(enm.Range(3, 4)))
+	assertResult(t, []int{}, enm.ToSlice(enm.Range(3, 0)))
+}
+
+func TestRange_Itr(t *testing.T) {
+	assertResult(t, []int{3, 4, 5, 6}, itr.ToSlice(itr.Range(3, 4)))
+	assertResult(t, []int{}, itr.ToSlice(itr.Range(3, 0)))
+}
+
+func TestRepeat_Enm(t *testing.T) {
+	assertResult(t, []string{"a", "a", "a"}, enm.ToSlice(enm.Repeat("a", 3)))
+	assertResult(t, []string{}, enm.ToSlice(enm.Repeat("a", 0)))
+}
+
+func TestRepeat_Itr(t *testing.T) {
+	assertResult(t, []string{"a", "a", "a"}, itr.ToSlice(itr.Repeat("a", 3)))
+	assertResult(t, []string{}, itr.ToSlice(itr.Repeat("a", 0)))
+}
+
+func TestGenerate_Enm(t *testing.T) {
+	before := runtime.NumGoroutine()
+
+	x1 := enm.Generate(1, func(i int) int { return i * 2 })
+	first, _ := enm.First(x1)
+	element10, _ := enm.ElementAt(x1, 10)
+	any := enm.Any(x1)
+	chunk, _ := enm.First(enm.Chunk(enm.Where(x1, func(i int) bool { return i > 2 }), 3))
+
+	assertResult(t, 1, first)
+	assertResult(t, 1024, element10)
+	assertResult(t, true, any)
+	assertResult(t, []int{4, 8, 16}, chunk)
+
+	// the generating goroutines must be cancelled, not left running
+	assertGoroutines(t, before)
+}
+
+func TestGenerate_Itr(t *testing.T) {
+	x1 := itr.Generate(1, func(i int) int { return i * 2 })
+	first, _ := itr.First(x1)
+	element10, _ := itr.ElementAt(x1, 10)
+	any := itr.Any(x1)
+	chunk, _ := itr.First(itr.Chunk(itr.Where(x1, func(i int) bool { return i > 2 }), 3))
+
+	assertResult(t, 1, first)
+	assertResult(t, 1024, element10)
+	assertResult(t, true, any)
+	assertResult(t, []int{4, 8, 16}, chunk)
+}
+
+func fibonacciBelow(limit int) func([2]int) (int, [2]int, bool) {
+	return func(s [2]int) (int, [2]int, bool) {
+		if s[0] >= limit {
+			return 0, s, false
+		}
+		return s[0], [2]int{s[1], s[0] + s[1]}, true
+	}
+}
+
+func TestUnfold_Enm(t *testing.T) {
+	x1 := enm.Unfold([2]int{0, 1}, fibonacciBelow(50))
+
+	assertResult(t, []int{0, 1, 1, 2, 3, 5, 8, 13, 21, 34}, enm.ToSlice(x1))
+}
+
+func TestUnfold_Itr(t *testing.T) {
+	x1 := itr.Unfold([2]int{0, 1}, fibonacciBelow(50))
+
+	assertResult(t, []int{0, 1, 1, 2, 3, 5, 8, 13, 21, 34}, itr.ToSlice(x1))
+}
+
+func TestUnfoldInfinite_Enm(t *testing.T) {
+	before := runtime.NumGoroutine()
+
+	x1 := enm.Unfold(0, func(s int) (string, int, bool) { return fmt.Sprint(s), s + 1, true })
+	element, _ := enm.ElementAt(x1, 3)
+
+	assertResult(t, "3", element)
+	assertGoroutines(t, before)
+}
+
+func TestUnfoldInfinite_Itr(t *testing.T) {
+	x1 := itr.Unfold(0, func(s int) (string, int, bool) { return fmt.Sprint(s), s + 1, true })
+	element, _ := itr.ElementAt(x1, 3)
+
+	assertResult(t, "3", element)
+}
+
+type countingReader struct {
+	Inner io.Reader
+	Reads atomic.Int32
+}
+
+func (x *countingReader) Read(p []byte) (int, error) {
+	x.Reads.Add(1)
+	return x.Inner.Read(p)
+}
+
+func TestFromLines_Enm(t *testing.T) {
+	x1, errFunc := enm.FromLines(strings.NewReader("James\nLucy\n\nZack\r\nAbi"))
+	x2 := enm.Where(x1, func(s string) bool { return s != "" })
+	lines := enm.ToSlice(x2)
+
+	assertResult(t, []string{"James", "Lucy", "Zack", "Abi"}, lines)
+	assertResult(t, nil, errFunc())
+}
+
+func TestFromLines_Itr(t *testing.T) {
+	x1, errFunc := itr.FromLines(strings.NewReader("James\nLucy\n\nZack\r\nAbi"))
+	x2 := itr.Where(x1, func(s string) bool { return s != "" })
+	lines := itr.ToSlice(x2)
+
+	assertResult(t, []string{"James", "Lucy", "Zack", "Abi"}, lines)
+	assertResult(t, nil, errFunc())
+}
+
+func TestFromScanner_Enm(t *testing.T) {
+	scanner := bufio.NewScanner(strings.NewReader("the quick  brown\nfox"))
+	scanner.Split(bufio.ScanWords)
+
+	x1, _ := enm.FromScanner(scanner)
+	x2 := enm.Select(x1, func(s string) int { return len(s) })
+
+	assertResult(t, []int{3, 5, 5, 3}, enm.ToSlice(x2))
+	assertPanics(t, cmn.ErrAlreadyEnumerated, func() { enm.ToSlice(x2) })
+}
+
+func TestFromScanner_Itr(t *testing.T) {
+	scanner := bufio.NewScanner(strings.NewReader("the quick  brown\nfox"))
+	scanner.Split(bufio.ScanWords)
+
+	x1, _ := itr.FromScanner(scanner)
+	x2 := itr.Select(x1, func(s string) int { return len(s) })
+
+	assertResult(t, []int{3, 5, 5, 3}, itr.ToSlice(x2))
+	assertPanics(t, cmn.ErrAlreadyEnumerated, func() { itr.ToSlice(x2) })
+}
+
+func TestFromLinesError_Enm(t *testing.T) {
+	errRead := errors.New("read failed")
+	reader := io.MultiReader(strings.NewReader("a\nb\n"), iotest.ErrReader(errRead))
+
+	x1, errFunc := enm.FromLines(reader)
+	lines := enm.ToSlice(x1)
+
+	assertResult(t, []string{"a", "b"}, lines)
+	assertResult(t, errRead, errFunc())
+}
+
+func TestFromLinesError_Itr(t *testing.T) {
+	errRead := errors.New("read failed")
+	reader := io.MultiReader(strings.NewReader("a\nb\n"), iotest.ErrReader(errRead))
+
+	x1, errFunc := itr.FromLines(reader)
+	lines := itr.ToSlice(x1)
+
+	assertResult(t, []string{"a", "b"}, lines)
+	assertResult(t, errRead, errFunc())
+}
+
+func TestFromLinesFirst_Enm(t *testing.T) {
+	before := runtime.NumGoroutine()
+	reader := &countingReader{Inner: iotest.OneByteReader(strings.NewReader(strings.Repeat("line\n", 100)))}
+
+	x1, _ := enm.FromLines(reader)
+	first, _ := enm.First(x1)
+
+	assertResult(t, "line", first)
+	assertGoroutines(t, before)
+	if reader.Reads.Load() > 50 {
+		t.Fatal("Expected reading to stop early, got reads: ", reader.Reads.Load())
+	}
+}
+
+func TestFromLinesFirst_Itr(t *testing.T) {
+	reader := &countingReader{Inner: iotest.OneByteReader(strings.NewReader(strings.Repeat("line\n", 100)))}
+
+	x1, _ := itr.FromLines(reader)
+	any := itr.Any(x1)
+
+	assertResult(t, true, any)
+	assertResult(t, int32(5), reader.Reads.Load())
+}
+
+type CsvPerson struct {
+	Name    string    `csv:"name"`
+	Age     int       `csv:"age"`
+	Height  float64   `csv:"height_m"`
+	Member  bool      `csv:"member"`
+	Joined  time.Time `csv:"joined" layout:"2006-01-02"`
+	Ignored string    `csv:"-"`
+}
+
+const personCsv = `name,age,height_m,member,joined,notes
+James,23,1.80,true,2020-01-02,first
+Lucy,thirty,1.65,false,2021-03-04,bad age
+Zack,41,,TRUE,2019-12-31,no height
+`
+
+func csvPeople() []CsvPerson {
+	return []CsvPerson{
+		{"James", 23, 1.80, true, time.Date(2020, 1, 2, 0, 0, 0, 0, time.UTC), ""},
+		{"Zack", 41, 0, true, time.Date(2019, 12, 31, 0, 0, 0, 0, time.UTC), ""},
+	}
+}
+
+func assertCsvAgeError(t *testing.T, err error, line int) {
+	var rowErr *cmn.RowError
+	var numErr *strconv.NumError
+	if !errors.As(err, &rowErr) || !errors.As(err, &numErr) {
+		t.Fatal("Expected row error wrapping strconv.NumError, got ", err)
+	}
+	assertResult(t, line, rowErr.Line)
+	assertResult(t, true, strings.Contains(err.Error(), `column "age"`))
+}
+
+func TestFromCSVStop_Enm(t *testing.T) {
+	x1, errFunc := enm.FromCSV[CsvPerson](csv.NewReader(strings.NewReader(personCsv)), cmn.StopOnError)
+	people := enm.ToSlice(x1)
+
+	assertResult(t, csvPeople()[:1], people)
+	assertCsvAgeError(t, errFunc(), 3)
+}
+
+func TestFromCSVStop_Itr(t *testing.T) {
+	x1, errFunc := itr.FromCSV[CsvPerson](csv.NewReader(strings.NewReader(personCsv)), cmn.StopOnError)
+	people := itr.ToSlice(x1)
+
+	assertResult(t, csvPeople()[:1], people)
+	assertCsvAgeError(t, errFunc(), 3)
+}
+
+func TestFromCSVSkip_Enm(t *testing.T) {
+	x1, errFunc := enm.FromCSV[CsvPerson](csv.NewReader(strings.NewReader(personCsv)), cmn.SkipErrors)
+	x2 := enm.Where(x1, func(p CsvPerson) bool { return p.Member })
+	x3 := enm.Select(x2, func(p CsvPerson) int { return p.Age })
+	avg, _ := enm.Avg(x3)
+
+	assertResult(t, 32.0, avg)
+	assertResult(t, nil, errFunc())
+}
+
+func TestFromCSVSkip_Itr(t *testing.T) {
+	x1, errFunc := itr.FromCSV[CsvPerson](csv.NewReader(strings.NewReader(personCsv)), cmn.SkipErrors)
+	x2 := itr.Where(x1, func(p CsvPerson) bool { return p.Member })
+	x3 := itr.Select(x2, func(p CsvPerson) int { return p.Age })
+	avg, _ := itr.Avg(x3)
+
+	assertResult(t, 32.0, avg)
+	assertResult(t, nil, errFunc())
+}
+
+func TestFromCSVCollect_Enm(t *testing.T) {
+	x1, errFunc := enm.FromCSV[CsvPerson](csv.NewReader(strings.NewReader(personCsv)), cmn.CollectErrors)
+	people := enm.ToSlice(x1)
+
+	assertResult(t, csvPeople(), people)
+	rowErrs, _ := errFunc().(cmn.RowErrors)
+	assertResult(t, 1, len(rowErrs))
+	assertCsvAgeError(t, rowErrs[0], 3)
+}
+
+func TestFromCSVCollect_Itr(t *testing.T) {
+	x1, errFunc := itr.FromCSV[CsvPerson](csv.NewReader(strings.NewReader(personCsv)), cmn.CollectErrors)
+	people := itr.ToSlice(x1)
+
+	assertResult(t, csvPeople(), people)
+	rowErrs, _ := errFunc().(cmn.RowErrors)
+	assertResult(t, 1, len(rowErrs))
+	assertCsvAgeError(t, rowErrs[0], 3)
+}
+
+func TestFromCSVRecords_Enm(t *testing.T) {
+	reader := csv.NewReader(strings.NewReader("a,b\n1,2\n3\n4,5\n"))
+	x1, errFunc := enm.FromCSVRecords(reader, cmn.CollectErrors)
+	records := enm.ToSlice(x1)
+
+	assertResult(t, [][]string{{"a", "b"}, {"1", "2"}, {"4", "5"}}, records)
+	rowErrs, _ := errFunc().(cmn.RowErrors)
+	assertResult(t, 1, len(rowErrs))
+	assertResult(t, 3, rowErrs[0].Line)
+	assertResult(t, csv.ErrFieldCount, rowErrs[0].Err)
+}
+
+func TestFromCSVRecords_Itr(t *testing.T) {
+	reader := csv.NewReader(strings.NewReader("a,b\n1,2\n3\n4,5\n"))
+	x1, errFunc := itr.FromCSVRecords(reader, cmn.CollectErrors)
+	records := itr.ToSlice(x1)
+
+	assertResult(t, [][]string{{"a", "b"}, {"1", "2"}, {"4", "5"}}, records)
+	rowErrs, _ := errFunc().(cmn.RowErrors)
+	assertResult(t, 1, len(rowErrs))
+	assertResult(t, 3, rowErrs[0].Line)
+	assertResult(t, csv.ErrFieldCount, rowErrs[0].Err)
+}
+
+const personJson = `[
+	{"Name": "James", "Age": 23},
+	{"Name": "Lucy", "Age": 33},
+	{"Name": "Zack", "Age": 41},
+	{"Name": "Abi", "Age": 19},
+	{"Name": "Rach", "Age": 33}
+]`
+
+const personJsonBadAge = `[{"Name":"James","Age":23},{"Name":"Lucy","Age":33},{"Name":"Zack","Age":"41"},{"Name":"Abi","Age":19}]`
+
+const personNdjson = `{"Name": "James", "Age": 23}
+{"Name": "Lucy", "Age": 33}
+{"Name": "Zack", "Age": 41
+{"Name": "Abi", "Age": 19}
+`
+
+func assertDecodeError(t *testing.T, err error, index int, offset int64) {
+	var decodeErr *cmn.DecodeError
+	if !errors.As(err, &decodeErr) {
+		t.Fatal("Expected decode error, got ", err)
+	}
+	assertResult(t, index, decodeErr.Index)
+	assertResult(t, offset, decodeErr.Offset)
+}
+
+func TestFromJSONArray_Enm(t *testing.T) {
+	x1, errFunc := enm.FromJSONArray[Person](strings.NewReader(personJson))
+	x2 := enm.Where(x1, func(p Person) bool { return p.Age > 30 })
+	accum := enm.Accumulate(x2, "", func(prior string, p Person) string { return prior + p.Name })
+
+	assertResult(t, "LucyZackRach", accum)
+	assertResult(t, nil, errFunc())
+}
+
+func TestFromJSONArray_Itr(t *testing.T) {
+	x1, errFunc := itr.FromJSONArray[Person](strings.NewReader(personJson))
+	x2 := itr.Where(x1, func(p Person) bool { return p.Age > 30 })
+	accum := itr.Accumulate(x2, "", func(prior string, p Person) string { return prior + p.Name })
+
+	assertResult(t, "LucyZackRach", accum)
+	assertResult(t, nil, errFunc())
+}
+
+func TestFromJSONArrayEmpty_Enm(t *testing.T) {
+	x1, errFunc := enm.FromJSONArray[Person](strings.NewReader(" [ ] "))
+
+	assertResult(t, []Person{}, enm.ToSlice(x1))
+	assertResult(t, nil, errFunc())
+}
+
+func TestFromJSONArrayEmpty_Itr(t *testing.T) {
+	x1, errFunc := itr.FromJSONArray[Person](strings.NewReader(" [ ] "))
+
+	assertResult(t, []Person{}, itr.ToSlice(x1))
+	assertResult(t, nil, errFunc())
+}
+
+func TestFromJSONArrayBadElement_Enm(t *testing.T) {
+	x1, errFunc := enm.FromJSONArray[Person](strings.NewReader(personJsonBadAge))
+	x2 := enm.Select(x1, personName)
+
+	assertResult(t, []string{"James", "Lucy"}, enm.ToSlice(x2))
+	assertDecodeError(t, errFunc(), 2, int64(strings.Index(personJsonBadAge, `,{"Name":"Zack"`)))
+	var typeErr *json.UnmarshalTypeError
+	assertResult(t, true, errors.As(errFunc(), &typeErr))
+}
+
+func TestFromJSONArrayBadElement_Itr(t *testing.T) {
+	x1, errFunc := itr.FromJSONArray[Person](strings.NewReader(personJsonBadAge))
+	x2 := itr.Select(x1, personName)
+
+	assertResult(t, []string{"James", "Lucy"}, itr.ToSlice(x2))
+	assertDecodeError(t, errFunc(), 2, int64(strings.Index(personJsonBadAge, `,{"Name":"Zack"`)))
+	var typeErr *json.UnmarshalTypeError
+	assertResult(t, true, errors.As(errFunc(), &typeErr))
+}
+
+func TestFromJSONArrayNotArray_Enm(t *testing.T) {
+	x1, errFunc := enm.FromJSONArray[Person](strings.NewReader(`{"Name": "James"}`))
+
+	assertResult(t, []Person{}, enm.ToSlice(x1))
+	assertDecodeError(t, errFunc(), 0, 0)
+}
+
+func TestFromJSONArrayNotArray_Itr(t *testing.T) {
+	x1, errFunc := itr.FromJSONArray[Person](strings.NewReader(`{"Name": "James"}`))
+
+	assertResult(t, []Person{}, itr.ToSlice(x1))
+	assertDecodeError(t, errFunc(), 0, 0)
+}
+
+func TestFromNDJSON_Enm(t *testing.T) {
+	x1, errFunc := enm.FromNDJSON[Person](strings.NewReader(personNdjson))
+	x2 := enm.Select(x1, personName)
+
+	assertResult(t, []string{"James", "Lucy"}, enm.ToSlice(x2))
+	// the syntax error is found just after the unexpected '{' that follows Zack
+	assertDecodeError(t, errFunc(), 2, int64(strings.Index(personNdjson, "\n{\"Name\": \"Abi\"")+2))
+	var syntaxErr *json.SyntaxError
+	assertResult(t, true, errors.As(errFunc(), &syntaxErr))
+}
+
+func TestFromNDJSON_Itr(t *testing.T) {
+	x1, errFunc := itr.FromNDJSON[Person](strings.NewReader(personNdjson))
+	x2 := itr.Select(x1, personName)
+
+	assertResult(t, []string{"James", "Lucy"}, itr.ToSlice(x2))
+	// the syntax error is found just after the unexpected '{' that follows Zack
+	assertDecodeError(t, errFunc(), 2, int64(strings.Index(personNdjson, "\n{\"Name\": \"Abi\"")+2))
+	var syntaxErr *json.SyntaxError
+	assertResult(t, true, errors.As(errFunc(), &syntaxErr))
+}
+
+func sourceTree() fstest.MapFS {
+	return fstest.MapFS{
+		"go.mod":             {Data: []byte("module example")},
+		"main.go":            {Data: []byte("package main")},
+		"cmd/tool/main.go":   {Data: []byte("package main")},
+		"cmd/tool/README.md": {Data: []byte("# tool")},
+		"docs/guide.md":      {Data: []byte("# guide")},
+		"internal/util.go":   {Data: []byte("package internal")},
+		"vendor/dep/dep.go":  {Data: []byte("package dep")},
+		"vendor/modules.txt": {Data: []byte("")},
+	}
+}
+
+func entryPath(e cmn.FSEntry) string { return e.Path }
+
+func TestFromFS_Enm(t *testing.T) {
+	x1, errFunc := enm.FromFS(sourceTree(), ".")
+	paths := enm.ToSlice(enm.Select(x1, entryPath))
+
+	expected := []string{".", "cmd", "cmd/tool", "cmd/tool/README.md", "cmd/tool/main.go",
+		"docs", "docs/guide.md", "go.mod", "internal", "internal/util.go", "main.go",
+		"vendor", "vendor/dep", "vendor/dep/dep.go", "vendor/modules.txt"}
+	assertResult(t, expected, paths)
+	assertResult(t, nil, errFunc())
+}
+
+func TestFromFS_Itr(t *testing.T) {
+	x1, errFunc := itr.FromFS(sourceTree(), ".")
+	paths := itr.ToSlice(itr.Select(x1, entryPath))
+
+	expected := []string{".", "cmd", "cmd/tool", "cmd/tool/README.md", "cmd/tool/main.go",
+		"docs", "docs/guide.md", "go.mod", "internal", "internal/util.go", "main.go",
+		"vendor", "vendor/dep", "vendor/dep/dep.go", "vendor/modules.txt"}
+	assertResult(t, expected, paths)
+	assertResult(t, nil, errFunc())
+}
+
+func TestFromFSOptions_Enm(t *testing.T) {
+	options := cmn.FSOptions{
+		Pattern: "*.go",
+		SkipDir: func(e cmn.FSEntry) bool { return e.Entry.Name() == "vendor" },
+	}
+	x1, _ := enm.FromFSWithOptions(sourceTree(), ".", options)
+	depths, ok := enm.ToMap(x1, entryPath, func(e cmn.FSEntry) int { return e.Depth })
+
+	assertResult(t, true, ok)
+	assertResult(t, map[string]int{"cmd/tool/main.go": 3, "internal/util.go": 2, "main.go": 1}, depths)
+}
+
+func TestFromFSOptions_Itr(t *testing.T) {
+	options := cmn.FSOptions{
+		Pattern: "*.go",
+		SkipDir: func(e cmn.FSEntry) bool { return e.Entry.Name() == "vendor" },
+	}
+	x1, _ := itr.FromFSWithOptions(sourceTree(), ".", options)
+	depths, ok := itr.ToMap(x1, entryPath, func(e cmn.FSEntry) int { return e.Depth })
+
+	assertResult(t, true, ok)
+	assertResult(t, map[string]int{"cmd/tool/main.go": 3, "internal/util.go": 2, "main.go": 1}, depths)
+}
+
+// countingFS counts the directories read from the wrapped file system
+type countingFS struct {
+	fs.FS
+	reads *atomic.Int32
+}
+
+func (x countingFS) ReadDir(name string) ([]fs.DirEntry, error) {
+	x.reads.Add(1)
+	return fs.ReadDir(x.FS, name)
+}
+
+func TestFromFSReadsLazily_Enm(t *testing.T) {
+	var reads atomic.Int32
+	x1, _ := enm.FromFS(countingFS{sourceTree(), &reads}, ".")
+
+	first, _ := enm.First(x1)
+	assertResult(t, ".", first.Path)
+	assertResult(t, []string{".", "cmd", "cmd/tool"}, enm.ToSlice(enm.Select(enm.Take(x1, 3), entryPath)))
+	assertResult(t, 15, len(enm.ToSlice(x1)))
+}
+
+func TestFromFSReadsLazily_Itr(t *testing.T) {
+	var reads atomic.Int32
+	x1, _ := itr.FromFS(countingFS{sourceTree(), &reads}, ".")
+
+	first, _ := itr.First(x1)
+	assertResult(t, ".", first.Path)
+	assertResult(t, int32(0), reads.Load())
+
+	assertResult(t, []string{".", "cmd"}, itr.ToSlice(itr.Select(itr.Take(x1, 2), entryPath)))
+	assertResult(t, int32(1), reads.Load())
+}
+
+func TestFromFSSkipSeenDir_Enm(t *testing.T) {
+	var mu sync.Mutex
+	skip := map[string]bool{}
+	options := cmn.FSOptions{SkipDir: func(e cmn.FSEntry) bool {
+		mu.Lock()
+		defer mu.Unlock()
+		return skip[e.Path]
+	}}
+	x1, _ := enm.FromFSWithOptions(sourceTree(), ".", options)
+
+	// prune each top level directory as the consumer reaches it; the walk
+	// may already have descended before the pipeline catches up
+	x2 := enm.Where(x1, func(e cmn.FSEntry) bool {
+		if e.Depth == 1 && e.Entry.IsDir() {
+			mu.Lock()
+			skip[e.Path] = true
+			mu.Unlock()
+		}
+		return true
+	})
+	paths := enm.ToSlice(enm.Select(x2, entryPath))
+
+	full, _ := enm.FromFS(sourceTree(), ".")
+	remaining := enm.ToSlice(enm.Select(full, entryPath))
+	for _, p := range paths {
+		for len(remaining) > 0 && remaining[0] != p {
+			remaining = remaining[1:]
+		}
+		if len(remaining) == 0 {
+			t.Fatal("Unexpected or out of order path: ", p)
+		}
+	}
+	topLevel := []string{}
+	for _, p := range paths {
+		if !strings.Contains(p, "/") {
+			topLevel = append(topLevel, p)
+		}
+	}
+	assertResult(t, []string{".", "cmd", "docs", "go.mod", "internal", "main.go", "vendor"}, topLevel)
+}
+
+func TestFromFSSkipSeenDir_Itr(t *testing.T) {
+	skip := map[string]bool{}
+	options := cmn.FSOptions{SkipDir: func(e cmn.FSEntry) bool { return skip[e.Path] }}
+	x1, _ := itr.FromFSWithOptions(sourceTree(), ".", options)
+
+	// prune each top level directory as the consumer reaches it
+	x2 := itr.Where(x1, func(e cmn.FSEntry) bool {
+		if e.Depth == 1 && e.Entry.IsDir() {
+			skip[e.Path] = true
+		}
+		return true
+	})
+
+	expected := []string{".", "cmd", "docs", "go.mod", "internal", "main.go", "vendor"}
+	assertResult(t, expected, itr.ToSlice(itr.Select(x2, entryPath)))
+}
+
+func TestFromFSMaxDepth_Enm(t *testing.T) {
+	x1, _ := enm.FromFSWithOptions(sourceTree(), "cmd", cmn.FSOptions{MaxDepth: 1})
+	x2 := enm.Where(x1, func(e cmn.FSEntry) bool { return e.Entry.IsDir() })
+	chunks := enm.ToSlice(enm.Chunk(enm.Select(x2, entryPath), 5))
+
+	assertResult(t, [][]string{{"cmd", "cmd/tool"}}, chunks)
+}
+
+func TestFromFSMaxDepth_Itr(t *testing.T) {
+	x1, _ := itr.FromFSWithOptions(sourceTree(), "cmd", cmn.FSOptions{MaxDepth: 1})
+	x2 := itr.Where(x1, func(e cmn.FSEntry) bool { return e.Entry.IsDir() })
+	chunks := itr.ToSlice(itr.Chunk(itr.Select(x2, entryPath), 5))
+
+	assertResult(t, [][]string{{"cmd", "cmd/tool"}}, chunks)
+}
+
+func TestFromFSMissingRoot_Enm(t *testing.T) {
+	x1, errFunc := enm.FromFS(sourceTree(), "missing")
+
+	assertResult(t, 0, len(enm.ToSlice(x1)))
+	assertResult(t, true, errors.Is(errFunc(), fs.ErrNotExist))
+}
+
+func TestFromFSMissingRoot_Itr(t *testing.T) {
+	x1, errFunc := itr.FromFS(sourceTree(), "missing")
+
+	assertResult(t, 0, len(itr.ToSlice(x1)))
+	assertResult(t, true, errors.Is(errFunc(), fs.ErrNotExist))
+}
+
+func scanPerson(rows *sql.Rows) (Person, error) {
+	var p Person
+	err := rows.Scan(&p.Name, &p.Age)
+	return p, err
+}
+
+func queryPeople(t *testing.T, query string) *sql.Rows {
+	rows, err := openFakeDB().Query(query)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return rows
+}
+
+// waits for every fake result set to be closed
+func assertRowsClosed(t *testing.T) {
+	deadline := time.Now().Add(time.Second)
+	for openFakeRows.Load() != 0 {
+		if time.Now().After(deadline) {
+			t.Fatal("Expected rows to be closed, open: ", openFakeRows.Load())
+		}
+		time.Sleep(time.Millisecond)
+	}
+}
+
+func TestFromRows_Enm(t *testing.T) {
+	x1, errFunc := enm.FromRows(queryPeople(t, "people"), scanPerson)
+	x2 := enm.Where(x1, func(p Person) bool { return p.Age > 30 })
+	names := enm.ToSlice(enm.Select(x2, personName))
+
+	assertResult(t, []string{"Lucy", "Zack", "Rach"}, names)
+	assertResult(t, nil, errFunc())
+	assertRowsClosed(t)
+}
+
+func TestFromRows_Itr(t *testing.T) {
+	x1, errFunc := itr.FromRows(queryPeople(t, "people"), scanPerson)
+	x2 := itr.Where(x1, func(p Person) bool { return p.Age > 30 })
+	names := itr.ToSlice(itr.Select(x2, personName))
+
+	assertResult(t, []string{"Lucy", "Zack", "Rach"}, names)
+	assertResult(t, nil, errFunc())
+	assertRowsClosed(t)
+}
+
+func TestFromRowsEarlyExit_Enm(t *testing.T) {
+	x1, _ := enm.FromRows(queryPeople(t, "people"), scanPerson)
+	first, _ := enm.First(enm.Select(x1, personName))
+	assertResult(t, "James", first)
+	assertRowsClosed(t)
+
+	x2, _ := enm.FromRows(queryPeople(t, "people"), scanPerson)
+	assertResult(t, true, enm.Any(x2))
+	assertRowsClosed(t)
+
+	x3, _ := enm.FromRows(queryPeople(t, "people"), scanPerson)
+	_, ok := enm.Single(x3)
+	assertResult(t, false, ok)
+	assertRowsClosed(t)
+}
+
+func TestFromRowsEarlyExit_Itr(t *testing.T) {
+	x1, _ := itr.FromRows(queryPeople(t, "people"), scanPerson)
+	first, _ := itr.First(itr.Select(x1, personName))
+	assertResult(t, "James", first)
+	assertRowsClosed(t)
+
+	x2, _ := itr.FromRows(queryPeople(t, "people"), scanPerson)
+	assertResult(t, true, itr.Any(x2))
+	assertRowsClosed(t)
+
+	x3, _ := itr.FromRows(queryPeople(t, "people"), scanPerson)
+	_, ok := itr.Single(x3)
+	assertResult(t, false, ok)
+	assertRowsClosed(t)
+}
+
+func TestFromRowsError_Enm(t *testing.T) {
+	x1, errFunc := enm.FromRows(queryPeople(t, "broken people"), scanPerson)
+	names := enm.ToSlice(enm.Select(x1, personName))
+
+	assertResult(t, []string{"James", "Lucy"}, names)
+	assertResult(t, errFakeRows, errFunc())
+	assertRowsClosed(t)
+}
+
+func TestFromRowsError_Itr(t *testing.T) {
+	x1, errFunc := itr.FromRows(queryPeople(t, "broken people"), scanPerson)
+	names := itr.ToSlice(itr.Select(x1, personName))
+
+	assertResult(t, []string{"James", "Lucy"}, names)
+	assertResult(t, errFakeRows, errFunc())
+	assertRowsClosed(t)
+}
+
+func TestFromRowsScanError_Enm(t *testing.T) {
+	scanAgeAsBool := func(rows *sql.Rows) (bool, error) {
+		var name string
+		var b bool
+		err := rows.Scan(&name, &b)
+		return b, err
+	}
+	x1, errFunc := enm.FromRows(queryPeople(t, "people"), scanAgeAsBool)
+
+	assertResult(t, 0, len(enm.ToSlice(x1)))
+	assertResult(t, true, errFunc() != nil)
+	assertRowsClosed(t)
+}
+
+func TestFromRowsScanError_Itr(t *testing.T) {
+	scanAgeAsBool := func(rows *sql.Rows) (bool, error) {
+		var name string
+		var b bool
+		err := rows.Scan(&name, &b)
+		return b, err
+	}
+	x1, errFunc := itr.FromRows(queryPeople(t, "people"), scanAgeAsBool)
+
+	assertResult(t, 0, len(itr.ToSlice(x1)))
+	assertResult(t, true, errFunc() != nil)
+	assertRowsClosed(t)
+}
+
+func TestFromRowsUnstarted_Enm(t *testing.T) {
+	x1, _ := enm.FromRows(queryPeople(t, "people"), scanPerson)
+	assertResult(t, []Person{}, enm.ToSlice(enm.Take(x1, 0)))
+	assertRowsClosed(t)
+
+	x2, _ := enm.FromRows(queryPeople(t, "people"), scanPerson)
+	x3 := enm.Union(enm.Range(0, 10), enm.Select(x2, personAge))
+	assertResult(t, []int{0, 1}, enm.ToSlice(enm.Take(x3, 2)))
+	assertRowsClosed(t)
+
+	x4, _ := enm.FromRows(queryPeople(t, "people"), scanPerson)
+	x5 := enm.Concat(enm.Range(0, 10), enm.Where(enm.Select(x4, personAge), func(i int) bool { return i > 0 }))
+	first, _ := enm.First(x5)
+	assertResult(t, 0, first)
+	assertRowsClosed(t)
+}
+
+func TestFromRowsUnstarted_Itr(t *testing.T) {
+	x1, _ := itr.FromRows(queryPeople(t, "people"), scanPerson)
+	assertResult(t, []Person{}, itr.ToSlice(itr.Take(x1, 0)))
+	assertRowsClosed(t)
+
+	x2, _ := itr.FromRows(queryPeople(t, "people"), scanPerson)
+	x3 := itr.Union(itr.Range(0, 10), itr.Select(x2, personAge))
+	assertResult(t, []int{0, 1}, itr.ToSlice(itr.Take(x3, 2)))
+	assertRowsClosed(t)
+
+	x4, _ := itr.FromRows(queryPeople(t, "people"), scanPerson)
+	x5 := itr.Concat(itr.Range(0, 10), itr.Where(itr.Select(x4, personAge), func(i int) bool { return i > 0 }))
+	first, _ := itr.First(x5)
+	assertResult(t, 0, first)
+	assertRowsClosed(t)
+}
+
+func TestFromMapSorted_Enm(t *testing.T) {
+	p := personMap5()
+
+	x1 := enm.FromMapSorted(&p)
+	x2 := enm.Select(x1, func(kvp KeyPerson) string { return kvp.Key })
+
+	for i := 0; i < 5; i++ {
+		assertResult(t, []string{"Abi", "James", "Lucy", "Rach", "Zack"}, enm.ToSlice(x2))
+	}
+}
+
+func TestFromMapSorted_Itr(t *testing.T) {
+	p := personMap5()
+
+	x1 := itr.FromMapSorted(&p)
+	x2 := itr.Select(x1, func(kvp KeyPerson) string { return kvp.Key })
+
+	for i := 0; i < 5; i++ {
+		assertResult(t, []string{"Abi", "James", "Lucy", "Rach", "Zack"}, itr.ToSlice(x2))
+	}
+}
+
+func TestFromMapSortedFunc_Enm(t *testing.T) {
+	p := personMap5()
+
+	byLengthThenName := func(a string, b string) bool {
+		if len(a) != len(b) {
+			return len(a) > len(b)
+		}
+		return a < b
+	}
+	x1 := enm.FromMapSortedFunc(&p, byLengthThenName)
+	x2 := enm.Select(x1, func(kvp KeyPerson) int { return kvp.Value.Age })
+
+	assertResult(t, []int{23, 33, 33, 41, 19}, enm.ToSlice(x2))
+}
+
+func TestFromMapSortedFunc_Itr(t *testing.T) {
+	p := personMap5()
+
+	byLengthThenName := func(a string, b string) bool {
+		if len(a) != len(b) {
+			return len(a) > len(b)
+		}
+		return a < b
+	}
+	x1 := itr.FromMapSortedFunc(&p, byLengthThenName)
+	x2 := itr.Select(x1, func(kvp KeyPerson) int { return kvp.Value.Age })
+
+	assertResult(t, []int{23, 33, 33, 41, 19}, itr.ToSlice(x2))
+}
+
+func TestFromMapSortedEmpty_Enm(t *testing.T) {
+	p := personMap0()
+
+	assertResult(t, []KeyPerson{}, enm.ToSlice(enm.FromMapSorted(&p)))
+}
+
+func TestFromMapSortedEmpty_Itr(t *testing.T) {
+	p := personMap0()
+
+	assertResult(t, []KeyPerson{}, itr.ToSlice(itr.FromMapSorted(&p)))
+}
+
+func TestFromMapEarlyExit_Itr(t *testing.T) {
+	p := map[int]string{}
+	for i := 0; i < 1000; i++ {
+		p[i] = fmt.Sprint(i)
+	}
+	before := runtime.NumGoroutine()
+
+	x1 := itr.FromMap(&p)
+	_, firstOk := itr.First(x1)
+	any := itr.Any(x1)
+	contains := itr.Contains(itr.Select(x1, func(kvp cmn.KeyValuePair[int, string]) int { return kvp.Key }), 7)
+
+	x2 := itr.PointersFromMap(&p)
+	_, ptrFirstOk := itr.First(x2)
+	ptrAny := itr.Any(x2)
+
+	assertResult(t, true, firstOk && any && contains && ptrFirstOk && ptrAny)
+	// early exits must not leave producers parked behind them
+	if runtime.NumGoroutine() > before {
+		t.Fatal("Expected no new goroutines, got ", runtime.NumGoroutine()-before)
+	}
+}
+
+func TestFromMapDeletedDuringIteration_Itr(t *testing.T) {
+	p := map[int]int{1: 10, 2: 20}
+
+	x1 := itr.Select(itr.FromMap(&p), func(kvp cmn.KeyValuePair[int, int]) int {
+		delete(p, 3-kvp.Key) // delete the entry not yet visited
+		return kvp.Value
+	})
+
+	assertResult(t, 1, len(itr.ToSlice(x1)))
+}
+
+func TestPointersFromSliceMutate_Enm(t *testing.T) {
+	p := personSlice5()
+
+	x1 := enm.PointersFromSlice(&p)
+	x2 := enm.Where(x1, func(ptr *Person) bool { return ptr.Age > 30 })
+	for _, ptr := range enm.ToSlice(x2) {
+		ptr.Age++
+	}
+
+	assertResult(t, []int{23, 34, 42, 19, 34}, enm.ToSlice(enm.Select(enm.FromSlice(&p), personAge)))
+}
+
+func TestPointersFromSliceMutate_Itr(t *testing.T) {
+	p := personSlice5()
+
+	x1 := itr.PointersFromSlice(&p)
+	x2 := itr.Where(x1, func(ptr *Person) bool { return ptr.Age > 30 })
+	for _, ptr := range itr.ToSlice(x2) {
+		ptr.Age++
+	}
+
+	assertResult(t, []int{23, 34, 42, 19, 34}, itr.ToSlice(itr.Select(itr.FromSlice(&p), personAge)))
+}
+
+func TestPointersFromMapDistinct_Enm(t *testing.T) {
+	p := personMap5()
+
+	x1 := enm.PointersFromMap(&p)
+	x2 := enm.Select(x1, func(kvp cmn.KeyValuePair[string, *Person]) *Person { return kvp.Value })
+	ptrs := enm.ToSlice(x2)
+
+	// every pair gets its own copy, holding the value of its own key
+	names := map[string]bool{}
+	for _, ptr := range ptrs {
+		names[ptr.Name] = true
+	}
+	assertResult(t, 5, len(names))
+}
+
+func TestPointersFromMapDistinct_Itr(t *testing.T) {
+	p := personMap5()
+
+	x1 := itr.PointersFromMap(&p)
+	x2 := itr.Select(x1, func(kvp cmn.KeyValuePair[string, *Person]) *Person { return kvp.Value })
+	ptrs := itr.ToSlice(x2)
+
+	// every pair gets its own copy, holding the value of its own key
+	names := map[string]bool{}
+	for _, ptr := range ptrs {
+		names[ptr.Name] = true
+	}
+	assertResult(t, 5, len(names))
+}
+
+func TestUpdateValues_Enm(t *testing.T) {
+	p := personMap5()
+
+	updated := enm.UpdateValues(&p,
+		func(kvp KeyPerson) bool { return kvp.Value.Age > 30 },
+		func(ptr *Person) { ptr.Age++ })
+
+	assertResult(t, 3, updated)
+	x1 := enm.Select(enm.FromMapSorted(&p), func(kvp KeyPerson) int { return kvp.Value.Age })
+	assertResult(t, []int{19, 23, 34, 34, 42}, enm.ToSlice(x1))
+}
+
+func TestUpdateValues_Itr(t *testing.T) {
+	p := personMap5()
+
+	updated := itr.UpdateValues(&p,
+		func(kvp KeyPerson) bool { return kvp.Value.Age > 30 },
+		func(ptr *Person) { ptr.Age++ })
+
+	assertResult(t, 3, updated)
+	x1 := itr.Select(itr.FromMapSorted(&p), func(kvp KeyPerson) int { return kvp.Value.Age })
+	assertResult(t, []int{19, 23, 34, 34, 42}, itr.ToSlice(x1))
+}
+
+func TestTakeSkip_Enm(t *testing.T) {
+	p := personSlice5()
+	x1 := enm.Select(enm.FromSlice(&p), personName)
+
+	assertResult(t, []string{"James", "Lucy"}, enm.ToSlice(enm.Take(x1, 2)))
+	assertResult(t, []string{}, enm.ToSlice(enm.Take(x1, 0)))
+	assertResult(t, 5, len(enm.ToSlice(enm.Take(x1, 10))))
+	assertResult(t, []string{"Abi", "Rach"}, enm.ToSlice(enm.Skip(x1, 3)))
+	assertResult(t, []string{}, enm.ToSlice(enm.Skip(x1, 10)))
+	assertResult(t, []string{"Zack", "Abi"}, enm.ToSlice(enm.Take(enm.Skip(x1, 2), 2)))
+}
+
+func TestTakeSkip_Itr(t *testing.T) {
+	p := personSlice5()
+	x1 := itr.Select(itr.FromSlice(&p), personName)
+
+	assertResult(t, []string{"James", "Lucy"}, itr.ToSlice(itr.Take(x1, 2)))
+	assertResult(t, []string{}, itr.ToSlice(itr.Take(x1, 0)))
+	assertResult(t, 5, len(itr.ToSlice(itr.Take(x1, 10))))
+	assertResult(t, []string{"Abi", "Rach"}, itr.ToSlice(itr.Skip(x1, 3)))
+	assertResult(t, []string{}, itr.ToSlice(itr.Skip(x1, 10)))
+	assertResult(t, []string{"Zack", "Abi"}, itr.ToSlice(itr.Take(itr.Skip(x1, 2), 2)))
+}
+
+func TestTakeWhileSkipWhile_Enm(t *testing.T) {
+	p := personSlice5()
+	x1 := enm.FromSlice(&p)
+	under40 := func(p Person) bool { return p.Age < 40 }
+
+	assertResult(t, []string{"James", "Lucy"}, enm.ToSlice(enm.Select(enm.TakeWhile(x1, under40), personName)))
+	assertResult(t, []string{"Zack", "Abi", "Rach"}, enm.ToSlice(enm.Select(enm.SkipWhile(x1, under40), personName)))
+}
+
+func TestTakeWhileSkipWhile_Itr(t *testing.T) {
+	p := personSlice5()
+	x1 := itr.FromSlice(&p)
+	under40 := func(p Person) bool { return p.Age < 40 }
+
+	assertResult(t, []string{"James", "Lucy"}, itr.ToSlice(itr.Select(itr.TakeWhile(x1, under40), personName)))
+	assertResult(t, []string{"Zack", "Abi", "Rach"}, itr.ToSlice(itr.Select(itr.SkipWhile(x1, under40), personName)))
+}
+
+func TestTakeLastSkipLast_Enm(t *testing.T) {
+	nums := intRange(1, 7)
+	x1 := enm.FromSlice(&nums)
+
+	assertResult(t, []int{6, 7}, enm.ToSlice(enm.TakeLast(x1, 2)))
+	assertResult(t, []int{}, enm.ToSlice(enm.TakeLast(x1, 0)))
+	assertResult(t, nums, enm.ToSlice(enm.TakeLast(x1, 10)))
+	assertResult(t, []int{1, 2, 3, 4, 5}, enm.ToSlice(enm.SkipLast(x1, 2)))
+	assertResult(t, nums, enm.ToSlice(enm.SkipLast(x1, 0)))
+	assertResult(t, []int{}, enm.ToSlice(enm.SkipLast(x1, 10)))
+}
+
+func TestTakeLastSkipLast_Itr(t *testing.T) {
+	nums := intRange(1, 7)
+	x1 := itr.FromSlice(&nums)
+
+	assertResult(t, []int{6, 7}, itr.ToSlice(itr.TakeLast(x1, 2)))
+	assertResult(t, []int{}, itr.ToSlice(itr.TakeLast(x1, 0)))
+	assertResult(t, nums, itr.ToSlice(itr.TakeLast(x1, 10)))
+	assertResult(t, []int{1, 2, 3, 4, 5}, itr.ToSlice(itr.SkipLast(x1, 2)))
+	assertResult(t, nums, itr.ToSlice(itr.SkipLast(x1, 0)))
+	assertResult(t, []int{}, itr.ToSlice(itr.SkipLast(x1, 10)))
+}
+
+func TestTakeCancelsUpstream_Enm(t *testing.T) {
+	before := runtime.NumGoroutine()
+
+	x1 := enm.Generate(1, func(i int) int { return i + 1 })
+	x2 := enm.TakeWhile(enm.Take(enm.Where(x1, func(i int) bool { return i%2 == 0 }), 4), func(i int) bool { return i < 7 })
+
+	assertResult(t, []int{2, 4, 6}, enm.ToSlice(x2))
+	assertGoroutines(t, before)
+}
+
+func TestTakeCancelsUpstream_Itr(t *testing.T) {
+	x1, _ := itr.FromRows(queryPeople(t, "people"), scanPerson)
+	x2 := itr.Take(x1, 2)
+
+	actual := []string{}
+	x3 := itr.Select(x2, func(p Person) string {
+		actual = append(actual, p.Name)
+		// rows are released as soon as the second is taken
+		if len(actual) == 2 {
+			assertRowsClosed(t)
+		}
+		return p.Name
+	})
+	itr.Count(x3)
+
+	assertResult(t, []string{"James", "Lucy"}, actual)
+}
+
+func personHobbies(p Person) []string {
+	hobbies := map[string][]string{
+		"James": {"golf", "chess"},
+		"Zack":  {"rowing"},
+		"Rach":  {"chess", "climbing", "cello"},
+	}
+	return hobbies[p.Name]
+}
+
+func TestSelectManySlice_Enm(t *testing.T) {
+	p := personSlice5()
+
+	x1 := enm.SelectManySlice(enm.FromSlice(&p), personHobbies)
+
+	assertResult(t, []string{"golf", "chess", "rowing", "chess", "climbing", "cello"}, enm.ToSlice(x1))
+}
+
+func TestSelectManySlice_Itr(t *testing.T) {
+	p := personSlice5()
+
+	x1 := itr.SelectManySlice(itr.FromSlice(&p), personHobbies)
+
+	assertResult(t, []string{"golf", "chess", "rowing", "chess", "climbing", "cello"}, itr.ToSlice(x1))
+}
+
+func TestSelectMany_Enm(t *testing.T) {
+	x1 := enm.SelectMany(enm.Range(1, 4), func(i int) enm.Enumerable[int] { return enm.Repeat(i, i-1) })
+
+	assertResult(t, []int{2, 3, 3, 4, 4, 4}, enm.ToSlice(x1))
+}
+
+func TestSelectMany_Itr(t *testing.T) {
+	x1 := itr.SelectMany(itr.Range(1, 4), func(i int) itr.Iterator[int] { return itr.Repeat(i, i-1) })
+
+	assertResult(t, []int{2, 3, 3, 4, 4, 4}, itr.ToSlice(x1))
+}
+
+func TestSelectManyResult_Enm(t *testing.T) {
+	p := personSlice5()
+
+	x1 := enm.SelectManyResult(enm.FromSlice(&p),
+		func(p Person) enm.Enumerable[string] {
+			hobbies := personHobbies(p)
+			return enm.FromSlice(&hobbies)
+		},
+		func(p Person, hobby string) string { return p.Name + ":" + hobby })
+	x2 := enm.Where(x1, func(s string) bool { return strings.HasSuffix(s, ":chess") })
+
+	assertResult(t, []string{"James:chess", "Rach:chess"}, enm.ToSlice(x2))
+}
+
+func TestSelectManyResult_Itr(t *testing.T) {
+	p := personSlice5()
+
+	x1 := itr.SelectManyResult(itr.FromSlice(&p),
+		func(p Person) itr.Iterator[string] {
+			hobbies := personHobbies(p)
+			return itr.FromSlice(&hobbies)
+		},
+		func(p Person, hobby string) string { return p.Name + ":" + hobby })
+	x2 := itr.Where(x1, func(s string) bool { return strings.HasSuffix(s, ":chess") })
+
+	assertResult(t, []string{"James:chess", "Rach:chess"}, itr.ToSlice(x2))
+}
+
+func TestFlatten_Enm(t *testing.T) {
+	nested := []enm.Enumerable[int]{enm.Range(1, 2), enm.Range(10, 0), enm.Range(5, 1)}
+
+	x1 := enm.Flatten(enm.FromSlice(&nested))
+
+	assertResult(t, []int{1, 2, 5}, enm.ToSlice(x1))
+}
+
+func TestFlatten_Itr(t *testing.T) {
+	nested := []itr.Iterator[int]{itr.Range(1, 2), itr.Range(10, 0), itr.Range(5, 1)}
+
+	x1 := itr.Flatten(itr.FromSlice(&nested))
+
+	assertResult(t, []int{1, 2, 5}, itr.ToSlice(x1))
+}
+
+func TestSelectManyCancelsInner_Enm(t *testing.T) {
+	before := runtime.NumGoroutine()
+
+	x1 := enm.Generate(1, func(i int) int { return i + 1 })
+	x2 := enm.SelectMany(x1, func(i int) enm.Enumerable[int] { return enm.Generate(i, func(j int) int { return j * 10 }) })
+	element, _ := enm.ElementAt(x2, 3)
+
+	assertResult(t, 1000, element)
+	assertGoroutines(t, before)
+}
+
+func TestSelectManyClosesInner_Itr(t *testing.T) {
+	x1 := itr.SelectMany(itr.Range(0, 3), func(i int) itr.Iterator[Person] {
+		rows, _ := itr.FromRows(queryPeople(t, "people"), scanPerson)
+		return rows
+	})
+	x2 := itr.Select(x1, personName)
+
+	assertResult(t, []string{"James", "Lucy"}, itr.ToSlice(itr.Take(x2, 2)))
+	assertRowsClosed(t)
+	assertResult(t, 15, len(itr.ToSlice(x2)))
+	assertRowsClosed(t)
+}
+
+func TestSelectIndexed_Enm(t *testing.T) {
+	input := personSlice5()
+	x1 := enm.FromSlice(&input)
+	x2 := enm.SelectIndexed(x1, func(p Person, i int) string { return strconv.Itoa(i) + ":" + p.Name })
+
+	expected := []string{"0:James", "1:Lucy", "2:Zack", "3:Abi", "4:Rach"}
+	assertResult(t, expected, enm.ToSlice(x2))
+	assertResult(t, expected, enm.ToSlice(x2))
+}
+
+func TestSelectIndexed_Itr(t *testing.T) {
+	input := personSlice5()
+	x1 := itr.FromSlice(&input)
+	x2 := itr.SelectIndexed(x1, func(p Person, i int) string { return strconv.Itoa(i) + ":" + p.Name })
+
+	expected := []string{"0:James", "1:Lucy", "2:Zack", "3:Abi", "4:Rach"}
+	assertResult(t, expected, itr.ToSlice(x2))
+	assertResult(t, expected, itr.ToSlice(x2))
+}
+
+func TestWhereIndexed_Enm(t *testing.T) {
+	x1 := enm.Range(10, 10)
+	x2 := enm.WhereIndexed(x1, func(x int, i int) bool { return i%3 != 2 })
+
+	expected := []int{10, 11, 13, 14, 16, 17, 19}
+	assertResult(t, expected, enm.ToSlice(x2))
+	assertResult(t, expected, enm.ToSlice(x2))
+}
+
+func TestWhereIndexed_Itr(t *testing.T) {
+	x1 := itr.Range(10, 10)
+	x2 := itr.WhereIndexed(x1, func(x int, i int) bool { return i%3 != 2 })
+
+	expected := []int{10, 11, 13, 14, 16, 17, 19}
+	assertResult(t, expected, itr.ToSlice(x2))
+	assertResult(t, expected, itr.ToSlice(x2))
+}
+
+func TestWithIndex_Enm(t *testing.T) {
+	x1 := enm.Where(enm.Range(1, 6), func(x int) bool { return x%2 == 0 })
+	x2 := enm.WithIndex(x1)
+
+	expected := []cmn.Indexed[int]{{Index: 0, Value: 2}, {Index: 1, Value: 4}, {Index: 2, Value: 6}}
+	assertResult(t, expected, enm.ToSlice(x2))
+	first, _ := enm.First(x2)
+	assertResult(t, cmn.Indexed[int]{Index: 0, Value: 2}, first)
+}
+
+func TestWithIndex_Itr(t *testing.T) {
+	x1 := itr.Where(itr.Range(1, 6), func(x int) bool { return x%2 == 0 })
+	x2 := itr.WithIndex(x1)
+
+	expected := []cmn.Indexed[int]{{Index: 0, Value: 2}, {Index: 1, Value: 4}, {Index: 2, Value: 6}}
+	assertResult(t, expected, itr.ToSlice(x2))
+	first, _ := itr.First(x2)
+	assertResult(t, cmn.Indexed[int]{Index: 0, Value: 2}, first)
+}
+
+func mixedSlice() []any {
+	return []any{1, "two", 3, nil, Person{"Abi", 19}, 6}
+}
+
+func assertCastError(t *testing.T, err error, index int, typeName string) {
+	var castErr *cmn.CastError
+	if !errors.As(err, &castErr) {
+		t.Fatalf("expected *CastError, got %v", err)
+	}
+	assertResult(t, index, castErr.Index)
+	assertResult(t, typeName, castErr.Type)
+	assertResult(t, "int", castErr.Target)
+}
+
+func assertCastPanic(t *testing.T, index int, typeName string, f func()) {
+	defer func() {
+		castErr, ok := recover().(*cmn.CastError)
+		if !ok {
+			t.Fatalf("expected *CastError panic, got %v", castErr)
+		}
+		assertResult(t, index, castErr.Index)
+		assertResult(t, typeName, castErr.Type)
+		assertResult(t, "int", castErr.Target)
+	}()
+	f()
+}
+
+func TestOfType_Enm(t *testing.T) {
+	input := mixedSlice()
+	x1 := enm.FromSlice(&input)
+
+	assertResult(t, []int{1, 3, 6}, enm.ToSlice(enm.OfType[any, int](x1)))
+	assertResult(t, []fmt.Stringer{}, enm.ToSlice(enm.OfType[any, fmt.Stringer](x1)))
+}
+
+func TestOfType_Itr(t *testing.T) {
+	input := mixedSlice()
+	x1 := itr.FromSlice(&input)
+
+	assertResult(t, []int{1, 3, 6}, itr.ToSlice(itr.OfType[any, int](x1)))
+	assertResult(t, []fmt.Stringer{}, itr.ToSlice(itr.OfType[any, fmt.Stringer](x1)))
+}
+
+func TestCast_Enm(t *testing.T) {
+	input := []any{4, 5, 6}
+	x1, err := enm.Cast[any, int](enm.FromSlice(&input))
+	x2 := enm.Where(x1, func(x int) bool { return x != 5 })
+
+	assertResult(t, []int{4, 6}, enm.ToSlice(x2))
+	assertResult(t, nil, err())
+}
+
+func TestCast_Itr(t *testing.T) {
+	input := []any{4, 5, 6}
+	x1 := itr.Where(itr.Cast[any, int](itr.FromSlice(&input)), func(x int) bool { return x != 5 })
+
+	assertResult(t, []int{4, 6}, itr.ToSlice(x1))
+}
+
+func TestCastFailure_Enm(t *testing.T) {
+	before := runtime.NumGoroutine()
+	input := mixedSlice()
+	x1, err := enm.Cast[any, int](enm.FromSlice(&input))
+
+	assertResult(t, []int{1}, enm.ToSlice(x1))
+	assertCastError(t, err(), 1, "string")
+
+	input2 := []any{1, 2, 3, nil}
+	x2, err2 := enm.Cast[any, int](enm.FromSlice(&input2))
+	assertResult(t, []int{1, 2, 3}, enm.ToSlice(x2))
+	assertCastError(t, err2(), 3, "<nil>")
+	assertGoroutines(t, before)
+}
+
+func TestCastFailure_Itr(t *testing.T) {
+	input := mixedSlice()
+	x1 := itr.Cast[any, int](itr.FromSlice(&input))
+
+	assertCastPanic(t, 1, "string", func() { itr.ToSlice(x1) })
+	input[1] = 2
+	assertCastPanic(t, 3, "<nil>", func() { itr.ToSlice(x1) })
+	input[3] = 4
+	assertCastPanic(t, 4, "test.Person", func() { itr.ToSlice(x1) })
+}
+
+func TestCastTarget_Itr(t *testing.T) {
+	input := []any{Person{"Abi", 19}}
+	x1 := itr.Cast[any, fmt.Stringer](itr.FromSlice(&input))
+
+	defer func() {
+		castErr := recover().(*cmn.CastError)
+		assertResult(t, "fmt.Stringer", castErr.Target)
+	}()
+	itr.ToSlice(x1)
+}
+
+func TestDistinctBy_Enm(t *testing.T) {
+	input := personSlice5()
+	x1 := enm.FromSlice(&input)
+	x2 := enm.DistinctBy(x1, personAge)
+
+	assertResult(t, []string{"James", "Lucy", "Zack", "Abi"}, enm.ToSlice(enm.Select(x2, personName)))
+}
+
+func TestDistinctBy_Itr(t *testing.T) {
+	input := personSlice5()
+	x1 := itr.FromSlice(&input)
+	x2 := itr.DistinctBy(x1, personAge)
+
+	assertResult(t, []string{"James", "Lucy", "Zack", "Abi"}, itr.ToSlice(itr.Select(x2, personName)))
+}
+
+func TestDistinctUntilChanged_Enm(t *testing.T) {
+	input := []int{0, 0, 1, 1, 1, 0, 2, 2, 1, 1}
+	x1 := enm.DistinctUntilChanged(enm.FromSlice(&input))
+
+	assertResult(t, []int{0, 1, 0, 2, 1}, enm.ToSlice(x1))
+	assertResult(t, []int{0, 1, 0, 2, 1}, enm.ToSlice(x1))
+}
+
+func TestDistinctUntilChanged_Itr(t *testing.T) {
+	input := []int{0, 0, 1, 1, 1, 0, 2, 2, 1, 1}
+	x1 := itr.DistinctUntilChanged(itr.FromSlice(&input))
+
+	assertResult(t, []int{0, 1, 0, 2, 1}, itr.ToSlice(x1))
+	assertResult(t, []int{0, 1, 0, 2, 1}, itr.ToSlice(x1))
+}
+
+func TestDistinctUntilChangedBy_Enm(t *testing.T) {
+	input := personSlice5()
+	x1 := enm.DistinctUntilChangedBy(enm.FromSlice(&input), func(p Person) bool { return p.Age > 30 })
+
+	assertResult(t, []string{"James", "Lucy", "Abi", "Rach"}, enm.ToSlice(enm.Select(x1, personName)))
+}
+
+func TestDistinctUntilChangedBy_Itr(t *testing.T) {
+	input := personSlice5()
+	x1 := itr.DistinctUntilChangedBy(itr.FromSlice(&input), func(p Person) bool { return p.Age > 30 })
+
+	assertResult(t, []string{"James", "Lucy", "Abi", "Rach"}, itr.ToSlice(itr.Select(x1, personName)))
+}
+
+func TestUnion_Enm(t *testing.T) {
+	a, b := []int{5, 3, 5, 1}, []int{2, 3, 4, 2}
+	x1 := enm.Union(enm.FromSlice(&a), enm.FromSlice(&b))
+
+	assertResult(t, []int{5, 3, 1, 2, 4}, enm.ToSlice(x1))
+}
+
+func TestUnion_Itr(t *testing.T) {
+	a, b := []int{5, 3, 5, 1}, []int{2, 3, 4, 2}
+	x1 := itr.Union(itr.FromSlice(&a), itr.FromSlice(&b))
+
+	assertResult(t, []int{5, 3, 1, 2, 4}, itr.ToSlice(x1))
+}
+
+func TestUnionCancels_Enm(t *testing.T) {
+	before := runtime.NumGoroutine()
+
+	x1 := enm.Union(enm.Generate(0, func(i int) int { return i + 1 }), enm.Range(0, 5))
+
+	assertResult(t, []int{0, 1, 2}, enm.ToSlice(enm.Take(x1, 3)))
+	assertGoroutines(t, before)
+}
+
+func TestIntersect_Enm(t *testing.T) {
+	a, b := []int{5, 3, 5, 1, 4, 3}, []int{4, 3, 2}
+	x1 := enm.Intersect(enm.FromSlice(&a), enm.FromSlice(&b))
+
+	assertResult(t, []int{3, 4}, enm.ToSlice(x1))
+	assertResult(t, []int{3, 4}, enm.ToSlice(x1))
+}
+
+func TestIntersect_Itr(t *testing.T) {
+	a, b := []int{5, 3, 5, 1, 4, 3}, []int{4, 3, 2}
+	x1 := itr.Intersect(itr.FromSlice(&a), itr.FromSlice(&b))
+
+	assertResult(t, []int{3, 4}, itr.ToSlice(x1))
+	assertResult(t, []int{3, 4}, itr.ToSlice(x1))
+}
+
+func TestExcept_Enm(t *testing.T) {
+	a, b := []int{5, 3, 5, 1, 4, 3}, []int{4, 3, 2}
+	x1 := enm.Except(enm.FromSlice(&a), enm.FromSlice(&b))
+
+	assertResult(t, []int{5, 1}, enm.ToSlice(x1))
+	assertResult(t, []int{5, 1}, enm.ToSlice(x1))
+}
+
+func TestExcept_Itr(t *testing.T) {
+	a, b := []int{5, 3, 5, 1, 4, 3}, []int{4, 3, 2}
+	x1 := itr.Except(itr.FromSlice(&a), itr.FromSlice(&b))
+
+	assertResult(t, []int{5, 1}, itr.ToSlice(x1))
+	assertResult(t, []int{5, 1}, itr.ToSlice(x1))
+}
+
+func TestSymmetricDifference_Enm(t *testing.T) {
+	a, b := []int{5, 3, 5, 1, 4}, []int{4, 6, 3, 2, 6}
+	x1 := enm.SymmetricDifference(enm.FromSlice(&a), enm.FromSlice(&b))
+
+	assertResult(t, []int{5, 1, 6, 2}, enm.ToSlice(x1))
+}
+
+func TestSymmetricDifference_Itr(t *testing.T) {
+	a, b := []int{5, 3, 5, 1, 4}, []int{4, 6, 3, 2, 6}
+	x1 := itr.SymmetricDifference(itr.FromSlice(&a), itr.FromSlice(&b))
+
+	assertResult(t, []int{5, 1, 6, 2}, itr.ToSlice(x1))
+}
+
+func TestSetOperatorsBy_Enm(t *testing.T) {
+	a, b := personSlice5(), []Person{{"Tom", 33}, {"Kim", 50}}
+	x1, x2 := enm.FromSlice(&a), enm.FromSlice(&b)
+
+	assertResult(t, []string{"James", "Lucy", "Zack", "Abi", "Kim"}, enm.ToSlice(enm.Select(enm.UnionBy(x1, x2, personAge), personName)))
+	assertResult(t, []string{"Lucy"}, enm.ToSlice(enm.Select(enm.IntersectBy(x1, x2, personAge), personName)))
+	assertResult(t, []string{"James", "Zack", "Abi"}, enm.ToSlice(enm.Select(enm.ExceptBy(x1, x2, personAge), personName)))
+}
+
+func TestSetOperatorsBy_Itr(t *testing.T) {
+	a, b := personSlice5(), []Person{{"Tom", 33}, {"Kim", 50}}
+	x1, x2 := itr.FromSlice(&a), itr.FromSlice(&b)
+
+	assertResult(t, []string{"James", "Lucy", "Zack", "Abi", "Kim"}, itr.ToSlice(itr.Select(itr.UnionBy(x1, x2, personAge), personName)))
+	assertResult(t, []string{"Lucy"}, itr.ToSlice(itr.Select(itr.IntersectBy(x1, x2, personAge), personName)))
+	assertResult(t, []string{"James", "Zack", "Abi"}, itr.ToSlice(itr.Select(itr.ExceptBy(x1, x2, personAge), personName)))
+}
+
+func TestConcat_Enm(t *testing.T) {
+	a, b := []int{1, 2}, []int{3}
+	x1 := enm.Concat(enm.FromSlice(&a), enm.Range(10, 0), enm.FromSlice(&b), enm.FromSlice(&a))
+
+	assertResult(t, []int{1, 2, 3, 1, 2}, enm.ToSlice(x1))
+	assertResult(t, []int{}, enm.ToSlice(enm.Concat[int]()))
+}
+
+func TestConcat_Itr(t *testing.T) {
+	a, b := []int{1, 2}, []int{3}
+	x1 := itr.Concat(itr.FromSlice(&a), itr.Range(10, 0), itr.FromSlice(&b), itr.FromSlice(&a))
+
+	assertResult(t, []int{1, 2, 3, 1, 2}, itr.ToSlice(x1))
+	assertResult(t, []int{}, itr.ToSlice(itr.Concat[int]()))
+}
+
+func TestConcatCancels_Enm(t *testing.T) {
+	before := runtime.NumGoroutine()
+
+	x1 := enm.Concat(enm.Range(0, 2), enm.Generate(5, func(i int) int { return i + 1 }), enm.Range(0, 2))
+
+	assertResult(t, []int{0, 1, 5, 6}, enm.ToSlice(enm.Take(x1, 4)))
+	assertGoroutines(t, before)
+}
+
+func TestConcatClosesActive_Itr(t *testing.T) {
+	rows, _ := itr.FromRows(queryPeople(t, "people"), scanPerson)
+	x1 := itr.Concat(itr.Select(rows, personName), itr.Repeat("never", 1))
+
+	assertResult(t, []string{"James", "Lucy"}, itr.ToSlice(itr.Take(x1, 2)))
+	assertRowsClosed(t)
+}
+
+func TestAppendPrepend_Enm(t *testing.T) {
+	x1 := enm.Prepend(enm.Append(enm.Range(1, 3), 8, 9), 0)
+
+	assertResult(t, []int{0, 1, 2, 3, 8, 9}, enm.ToSlice(x1))
+}
+
+func TestAppendPrepend_Itr(t *testing.T) {
+	x1 := itr.Prepend(itr.Append(itr.Range(1, 3), 8, 9), 0)
+
+	assertResult(t, []int{0, 1, 2, 3, 8, 9}, itr.ToSlice(x1))
+}
+
+func TestZip_Enm(t *testing.T) {
+	names := []string{"a", "b", "c"}
+	x1 := enm.Zip(enm.FromSlice(&names), enm.Range(1, 5))
+
+	expected := []cmn.Pair[string, int]{{First: "a", Second: 1}, {First: "b", Second: 2}, {First: "c", Second: 3}}
+	assertResult(t, expected, enm.ToSlice(x1))
+}
+
+func TestZip_Itr(t *testing.T) {
+	names := []string{"a", "b", "c"}
+	x1 := itr.Zip(itr.FromSlice(&names), itr.Range(1, 5))
+
+	expected := []cmn.Pair[string, int]{{First: "a", Second: 1}, {First: "b", Second: 2}, {First: "c", Second: 3}}
+	assertResult(t, expected, itr.ToSlice(x1))
+}
+
+func TestZipWith_Enm(t *testing.T) {
+	x1 := enm.ZipWith(enm.Range(1, 3), enm.Range(10, 4), func(a, b int) int { return a * b })
+
+	assertResult(t, []int{10, 22, 36}, enm.ToSlice(x1))
+}
+
+func TestZipWith_Itr(t *testing.T) {
+	x1 := itr.ZipWith(itr.Range(1, 3), itr.Range(10, 4), func(a, b int) int { return a * b })
+
+	assertResult(t, []int{10, 22, 36}, itr.ToSlice(x1))
+}
+
+func TestZip3_Enm(t *testing.T) {
+	input := personSlice5()
+	x1 := enm.Zip3(enm.Range(0, 2), enm.FromSlice(&input), enm.Repeat(true, 3))
+
+	expected := []cmn.Triple[int, Person, bool]{{First: 0, Second: input[0], Third: true}, {First: 1, Second: input[1], Third: true}}
+	assertResult(t, expected, enm.ToSlice(x1))
+}
+
+func TestZip3_Itr(t *testing.T) {
+	input := personSlice5()
+	x1 := itr.Zip3(itr.Range(0, 2), itr.FromSlice(&input), itr.Repeat(true, 3))
+
+	expected := []cmn.Triple[int, Person, bool]{{First: 0, Second: input[0], Third: true}, {First: 1, Second: input[1], Third: true}}
+	assertResult(t, expected, itr.ToSlice(x1))
+}
+
+func TestZipLongest_Enm(t *testing.T) {
+	names := []string{"a", "b"}
+	x1 := enm.ZipLongest(enm.FromSlice(&names), enm.Range(1, 3), "?", -1)
+	x2 := enm.ZipLongest(enm.Range(1, 3), enm.FromSlice(&names), -1, "?")
+
+	assertResult(t, []cmn.Pair[string, int]{{First: "a", Second: 1}, {First: "b", Second: 2}, {First: "?", Second: 3}}, enm.ToSlice(x1))
+	assertResult(t, []cmn.Pair[int, string]{{First: 1, Second: "a"}, {First: 2, Second: "b"}, {First: 3, Second: "?"}}, enm.ToSlice(x2))
+}
+
+func TestZipLongest_Itr(t *testing.T) {
+	names := []string{"a", "b"}
+	x1 := itr.ZipLongest(itr.FromSlice(&names), itr.Range(1, 3), "?", -1)
+	x2 := itr.ZipLongest(itr.Range(1, 3), itr.FromSlice(&names), -1, "?")
+
+	assertResult(t, []cmn.Pair[string, int]{{First: "a", Second: 1}, {First: "b", Second: 2}, {First: "?", Second: 3}}, itr.ToSlice(x1))
+	assertResult(t, []cmn.Pair[int, string]{{First: 1, Second: "a"}, {First: 2, Second: "b"}, {First: 3, Second: "?"}}, itr.ToSlice(x2))
+}
+
+func TestZipStaysExhausted_Enm(t *testing.T) {
+	letters := []string{"a", "b", "c", "d", "e", "f", "g"}
+	x1 := enm.Chunk(enm.Zip(enm.Range(0, 3), enm.FromSlice(&letters)), 2)
+
+	expected := [][]cmn.Pair[int, string]{{{First: 0, Second: "a"}, {First: 1, Second: "b"}}, {{First: 2, Second: "c"}}}
+	assertResult(t, expected, enm.ToSlice(x1))
+}
+
+func TestZipStaysExhausted_Itr(t *testing.T) {
+	letters := []string{"a", "b", "c", "d", "e", "f", "g"}
+	x1 := itr.Chunk(itr.Zip(itr.Range(0, 3), itr.FromSlice(&letters)), 2)
+	x2 := itr.Chunk(itr.Zip(itr.FromSlice(&letters), itr.Range(0, 3)), 2)
+
+	expected := [][]cmn.Pair[int, string]{{{First: 0, Second: "a"}, {First: 1, Second: "b"}}, {{First: 2, Second: "c"}}}
+	assertResult(t, expected, itr.ToSlice(x1))
+	assertResult(t, 2, len(itr.ToSlice(x2)))
+	assertResult(t, 3, len(itr.ToSlice(itr.Chunk(itr.ZipLongest(itr.Range(0, 3), itr.FromSlice(&letters), -1, "?"), 3))))
+}
+
+func TestZipCancelsOtherSide_Enm(t *testing.T) {
+	before := runtime.NumGoroutine()
+
+	x1 := enm.Zip(enm.Range(0, 3), enm.Generate(0, func(i int) int { return i + 1 }))
+	x2 := enm.Zip(enm.Generate(0, func(i int) int { return i + 1 }), enm.Range(0, 3))
+
+	assertResult(t, 3, len(enm.ToSlice(x1)))
+	assertResult(t, 3, len(enm.ToSlice(x2)))
+	first, _ := enm.First(enm.Zip(enm.Generate(0, func(i int) int { return i + 1 }), enm.Generate(0, func(i int) int { return i - 1 })))
+	assertResult(t, cmn.Pair[int, int]{First: 0, Second: 0}, first)
+	assertGoroutines(t, before)
+}
+
+func TestZipClosesBothSides_Itr(t *testing.T) {
+	rows, _ := itr.FromRows(queryPeople(t, "people"), scanPerson)
+	x1 := itr.Zip(itr.Range(0, 2), rows)
+
+	assertResult(t, 2, len(itr.ToSlice(x1)))
+	assertRowsClosed(t)
+}
+
+type Order struct {
+	Buyer string
+	Item  string
+}
+
+func orderSlice() []Order {
+	return []Order{{"Lucy", "book"}, {"James", "pen"}, {"Lucy", "lamp"}, {"Nobody", "cup"}}
+}
+
+func orderBuyer(o Order) string {
+	return o.Buyer
+}
+
+func describeOrder(p *Person, o *Order) string {
+	name, item := "-", "-"
+	if p != nil {
+		name = p.Name
+	}
+	if o != nil {
+		item = o.Item
+	}
+	return name + ":" + item
+}
+
+func TestJoin_Enm(t *testing.T) {
+	people, orders := personSlice5(), orderSlice()
+	x1 := enm.Join(enm.FromSlice(&people), enm.FromSlice(&orders), personName, orderBuyer,
+		func(p Person, o Order) string { return describeOrder(&p, &o) })
+
+	expected := []string{"James:pen", "Lucy:book", "Lucy:lamp"}
+	assertResult(t, expected, enm.ToSlice(x1))
+	assertResult(t, expected, enm.ToSlice(x1))
+}
+
+func TestJoin_Itr(t *testing.T) {
+	people, orders := personSlice5(), orderSlice()
+	x1 := itr.Join(itr.FromSlice(&people), itr.FromSlice(&orders), personName, orderBuyer,
+		func(p Person, o Order) string { return describeOrder(&p, &o) })
+
+	expected := []string{"James:pen", "Lucy:book", "Lucy:lamp"}
+	assertResult(t, expected, itr.ToSlice(x1))
+	assertResult(t, expected, itr.ToSlice(x1))
+}
+
+func TestGroupJoin_Enm(t *testing.T) {
+	people, orders := personSlice5(), orderSlice()
+	x1 := enm.GroupJoin(enm.FromSlice(&people), enm.FromSlice(&orders), personName, orderBuyer,
+		func(p Person, o enm.Enumerable[Order]) string { return p.Name + "=" + strconv.Itoa(int(enm.Count(o))) })
+
+	assertResult(t, []string{"James=1", "Lucy=2", "Zack=0", "Abi=0", "Rach=0"}, enm.ToSlice(x1))
+}
+
+func TestGroupJoin_Itr(t *testing.T) {
+	people, orders := personSlice5(), orderSlice()
+	x1 := itr.GroupJoin(itr.FromSlice(&people), itr.FromSlice(&orders), personName, orderBuyer,
+		func(p Person, o itr.Iterator[Order]) string { return p.Name + "=" + strconv.Itoa(int(itr.Count(o))) })
+
+	assertResult(t, []string{"James=1", "Lucy=2", "Zack=0", "Abi=0", "Rach=0"}, itr.ToSlice(x1))
+}
+
+func TestLeftJoin_Enm(t *testing.T) {
+	people, orders := personSlice1(), orderSlice()
+	people = append(people, Person{"Lucy", 33})
+	x1 := enm.LeftJoin(enm.FromSlice(&people), enm.FromSlice(&orders), personName, orderBuyer,
+		func(p Person, o *Order) string { return describeOrder(&p, o) })
+
+	assertResult(t, []string{"James:pen", "Lucy:book", "Lucy:lamp"}, enm.ToSlice(x1))
+
+	people = append(people, Person{"Zack", 41})
+	assertResult(t, []string{"James:pen", "Lucy:book", "Lucy:lamp", "Zack:-"}, enm.ToSlice(x1))
+}
+
+func TestLeftJoin_Itr(t *testing.T) {
+	people, orders := personSlice1(), orderSlice()
+	people = append(people, Person{"Lucy", 33})
+	x1 := itr.LeftJoin(itr.FromSlice(&people), itr.FromSlice(&orders), personName, orderBuyer,
+		func(p Person, o *Order) string { return describeOrder(&p, o) })
+
+	assertResult(t, []string{"James:pen", "Lucy:book", "Lucy:lamp"}, itr.ToSlice(x1))
+
+	people = append(people, Person{"Zack", 41})
+	assertResult(t, []string{"James:pen", "Lucy:book", "Lucy:lamp", "Zack:-"}, itr.ToSlice(x1))
+}
+
+func TestFullOuterJoin_Enm(t *testing.T) {
+	people, orders := personSlice5()[:3], orderSlice()
+	x1 := enm.FullOuterJoin(enm.FromSlice(&people), enm.FromSlice(&orders), personName, orderBuyer, describeOrder)
+
+	assertResult(t, []string{"James:pen", "Lucy:book", "Lucy:lamp", "Zack:-", "-:cup"}, enm.ToSlice(x1))
+}
+
+func TestFullOuterJoin_Itr(t *testing.T) {
+	people, orders := personSlice5()[:3], orderSlice()
+	x1 := itr.FullOuterJoin(itr.FromSlice(&people), itr.FromSlice(&orders), personName, orderBuyer, describeOrder)
+
+	assertResult(t, []string{"James:pen", "Lucy:book", "Lucy:lamp", "Zack:-", "-:cup"}, itr.ToSlice(x1))
+}
+
+func TestJoinCancels_Enm(t *testing.T) {
+	before := runtime.NumGoroutine()
+
+	x1 := enm.Join(enm.Generate(0, func(i int) int { return i + 1 }), enm.Range(0, 10),
+		func(i int) int { return i % 10 }, func(i int) int { return i }, func(a, b int) int { return a * b })
+
+	assertResult(t, []int{0, 1, 4}, enm.ToSlice(enm.Take(x1, 3)))
+	assertGoroutines(t, before)
+}
+
+func TestGroupBy_Enm(t *testing.T) {
+	input := personSlice5()
+	x1 := enm.GroupBy(enm.FromSlice(&input), personAge)
+
+	expected := []cmn.Grouping[int, Person]{
+		{Key: 23, Items: []Person{input[0]}},
+		{Key: 33, Items: []Person{input[1], input[4]}},
+		{Key: 41, Items: []Person{input[2]}},
+		{Key: 19, Items: []Person{input[3]}},
+	}
+	assertResult(t, expected, enm.ToSlice(x1))
+	assertResult(t, expected, enm.ToSlice(x1))
+}
+
+func TestGroupBy_Itr(t *testing.T) {
+	input := personSlice5()
+	x1 := itr.GroupBy(itr.FromSlice(&input), personAge)
+
+	expected := []cmn.Grouping[int, Person]{
+		{Key: 23, Items: []Person{input[0]}},
+		{Key: 33, Items: []Person{input[1], input[4]}},
+		{Key: 41, Items: []Person{input[2]}},
+		{Key: 19, Items: []Person{input[3]}},
+	}
+	assertResult(t, expected, itr.ToSlice(x1))
+	assertResult(t, expected, itr.ToSlice(x1))
+}
+
+func TestGroupByElement_Enm(t *testing.T) {
+	input := personSlice5()
+	x1 := enm.GroupByElement(enm.FromSlice(&input), func(p Person) bool { return p.Age >= 30 }, personName)
+	x2 := enm.Select(x1, func(g cmn.Grouping[bool, string]) []string { return enm.ToSlice(enm.FromGrouping(g)) })
+
+	assertResult(t, [][]string{{"James", "Abi"}, {"Lucy", "Zack", "Rach"}}, enm.ToSlice(x2))
+}
+
+func TestGroupByElement_Itr(t *testing.T) {
+	input := personSlice5()
+	x1 := itr.GroupByElement(itr.FromSlice(&input), func(p Person) bool { return p.Age >= 30 }, personName)
+	x2 := itr.Select(x1, func(g cmn.Grouping[bool, string]) []string { return itr.ToSlice(itr.FromGrouping(g)) })
+
+	assertResult(t, [][]string{{"James", "Abi"}, {"Lucy", "Zack", "Rach"}}, itr.ToSlice(x2))
+}
+
+func TestGroupByResult_Enm(t *testing.T) {
+	input := personSlice5()
+	x1 := enm.GroupByResult(enm.FromSlice(&input), func(p Person) bool { return p.Age >= 30 }, personAge,
+		func(old bool, ages enm.Enumerable[int]) string { return fmt.Sprint(old, enm.Sum(ages)) })
+
+	assertResult(t, []string{"false 42", "true 107"}, enm.ToSlice(x1))
+}
+
+func TestGroupByResult_Itr(t *testing.T) {
+	input := personSlice5()
+	x1 := itr.GroupByResult(itr.FromSlice(&input), func(p Person) bool { return p.Age >= 30 }, personAge,
+		func(old bool, ages itr.Iterator[int]) string { return fmt.Sprint(old, itr.Sum(ages)) })
+
+	assertResult(t, []string{"false 42", "true 107"}, itr.ToSlice(x1))
+}
+
+func TestGroupByEmpty_Enm(t *testing.T) {
+	x1 := enm.GroupBy(enm.Range(0, 0), func(i int) int { return i })
+
+	assertResult(t, []cmn.Grouping[int, int]{}, enm.ToSlice(x1))
+}
+
+func TestGroupByEmpty_Itr(t *testing.T) {
+	x1 := itr.GroupBy(itr.Range(0, 0), func(i int) int { return i })
+
+	assertResult(t, []cmn.Grouping[int, int]{}, itr.ToSlice(x1))
+}
+
+func TestOrderBy_Enm(t *testing.T) {
+	input := personSlice5()
+	x1 := enm.FromSlice(&input)
+
+	assertResult(t, []string{"Abi", "James", "Lucy", "Rach", "Zack"}, enm.ToSlice(enm.Select(enm.OrderBy(x1, personAge), personName)))
+	assertResult(t, []string{"Zack", "Lucy", "Rach", "James", "Abi"}, enm.ToSlice(enm.Select(enm.OrderByDescending(x1, personAge), personName)))
+}
+
+func TestOrderBy_Itr(t *testing.T) {
+	input := personSlice5()
+	x1 := itr.FromSlice(&input)
+
+	assertResult(t, []string{"Abi", "James", "Lucy", "Rach", "Zack"}, itr.ToSlice(itr.Select(itr.OrderBy(x1, personAge), personName)))
+	assertResult(t, []string{"Zack", "Lucy", "Rach", "James", "Abi"}, itr.ToSlice(itr.Select(itr.OrderByDescending(x1, personAge), personName)))
+}
+
+func TestThenBy_Enm(t *testing.T) {
+	input := append(personSlice5(), Person{"Bea", 23}, Person{"Cal", 33})
+	x1 := enm.OrderByDescending(enm.FromSlice(&input), personAge)
+
+	assertResult(t, []string{"Zack", "Cal", "Lucy", "Rach", "Bea", "James", "Abi"}, enm.ToSlice(enm.Select(enm.ThenBy(x1, personName), personName)))
+	assertResult(t, []string{"Zack", "Rach", "Lucy", "Cal", "James", "Bea", "Abi"}, enm.ToSlice(enm.Select(enm.ThenByDescending(x1, personName), personName)))
+	assertResult(t, []string{"Zack", "Lucy", "Rach", "Cal", "James", "Bea", "Abi"}, enm.ToSlice(enm.Select(x1, personName)))
+}
+
+func TestThenBy_Itr(t *testing.T) {
+	input := append(personSlice5(), Person{"Bea", 23}, Person{"Cal", 33})
+	x1 := itr.OrderByDescending(itr.FromSlice(&input), personAge)
+
+	assertResult(t, []string{"Zack", "Cal", "Lucy", "Rach", "Bea", "James", "Abi"}, itr.ToSlice(itr.Select(itr.ThenBy(x1, personName), personName)))
+	assertResult(t, []string{"Zack", "Rach", "Lucy", "Cal", "James", "Bea", "Abi"}, itr.ToSlice(itr.Select(itr.ThenByDescending(x1, personName), personName)))
+	assertResult(t, []string{"Zack", "Lucy", "Rach", "Cal", "James", "Bea", "Abi"}, itr.ToSlice(itr.Select(x1, personName)))
+}
+
+func TestOrderByFunc_Enm(t *testing.T) {
+	input := []string{"ccc", "a", "bb", "dd"}
+	x1 := enm.OrderByFunc(enm.FromSlice(&input), func(a, b string) bool { return len(a) < len(b) })
+	x2 := enm.ThenByFunc(x1, func(a, b string) bool { return a > b })
+
+	assertResult(t, []string{"a", "dd", "bb", "ccc"}, enm.ToSlice(x2))
+}
+
+func TestOrderByFunc_Itr(t *testing.T) {
+	input := []string{"ccc", "a", "bb", "dd"}
+	x1 := itr.OrderByFunc(itr.FromSlice(&input), func(a, b string) bool { return len(a) < len(b) })
+	x2 := itr.ThenByFunc(x1, func(a, b string) bool { return a > b })
+
+	assertResult(t, []string{"a", "dd", "bb", "ccc"}, itr.ToSlice(x2))
+}
+
+func TestThenByUnordered_Enm(t *testing.T) {
+	assertPanics(t, cmn.ErrNotOrdered, func() { enm.ThenBy(enm.Range(0, 3), func(i int) int { return i }) })
+}
+
+func TestThenByUnordered_Itr(t *testing.T) {
+	assertPanics(t, cmn.ErrNotOrdered, func() { itr.ThenBy(itr.Range(0, 3), func(i int) int { return i }) })
+}
+
+func TestOrderByFirst_Enm(t *testing.T) {
+	compares := 0
+	x1 := enm.OrderByFunc(enm.Range(0, 1000), func(a, b int) bool {
+		compares++
+		return (a*7919)%1000 < (b*7919)%1000
+	})
+	x2 := enm.ThenByDescending(enm.OrderBy(enm.Range(0, 10), func(i int) int { return i % 3 }), func(i int) int { return i })
+
+	first, ok := enm.First(x1)
+	assertResult(t, 0, first)
+	assertResult(t, true, ok)
+	assertResult(t, true, compares <= 2*1000)
+	assertResult(t, 9, enm.FirstOrDefault(x2))
+	_, ok = enm.First(enm.OrderBy(enm.Range(0, 0), func(i int) int { return i }))
+	assertResult(t, false, ok)
+}
+
+func TestOrderByFirst_Itr(t *testing.T) {
+	compares := 0
+	x1 := itr.OrderByFunc(itr.Range(0, 1000), func(a, b int) bool {
+		compares++
+		return (a*7919)%1000 < (b*7919)%1000
+	})
+	x2 := itr.ThenByDescending(itr.OrderBy(itr.Range(0, 10), func(i int) int { return i % 3 }), func(i int) int { return i })
+
+	first, ok := itr.First(x1)
+	assertResult(t, 0, first)
+	assertResult(t, true, ok)
+	assertResult(t, true, compares <= 2*1000)
+	assertResult(t, 9, itr.FirstOrDefault(x2))
+	_, ok = itr.First(itr.OrderBy(itr.Range(0, 0), func(i int) int { return i }))
+	assertResult(t, false, ok)
+}
+
+func TestToLookup_Enm(t *testing.T) {
+	input := personSlice5()
+	x1 := enm.ToLookup(enm.FromSlice(&input), personAge, personName)
+
+	assertResult(t, 4, x1.Count())
+	assertResult(t, []int{23, 33, 41, 19}, enm.ToSlice(x1.Keys()))
+	assertResult(t, []string{"Lucy", "Rach"}, enm.ToSlice(x1.Get(33)))
+	assertResult(t, []string{}, enm.ToSlice(x1.Get(50)))
+	assertResult(t, true, x1.Contains(19))
+	assertResult(t, false, x1.Contains(50))
+}
+
+func TestToLookup_Itr(t *testing.T) {
+	input := personSlice5()
+	x1 := itr.ToLookup(itr.FromSlice(&input), personAge, personName)
+
+	assertResult(t, 4, x1.Count())
+	assertResult(t, []int{23, 33, 41, 19}, itr.ToSlice(x1.Keys()))
+	assertResult(t, []string{"Lucy", "Rach"}, itr.ToSlice(x1.Get(33)))
+	assertResult(t, []string{}, itr.ToSlice(x1.Get(50)))
+	assertResult(t, true, x1.Contains(19))
+	assertResult(t, false, x1.Contains(50))
+}
+
+func TestToLookupEmpty_Enm(t *testing.T) {
+	x1 := enm.ToLookup(enm.Range(0, 0), func(i int) int { return i }, func(i int) int { return i })
+
+	assertResult(t, 0, x1.Count())
+	assertResult(t, []int{}, enm.ToSlice(x1.Keys()))
+}
+
+func TestToLookupEmpty_Itr(t *testing.T) {
+	x1 := itr.ToLookup(itr.Range(0, 0), func(i int) int { return i }, func(i int) int { return i })
+
+	assertResult(t, 0, x1.Count())
+	assertResult(t, []int{}, itr.ToSlice(x1.Keys()))
+}
+
+func TestWindow_Enm(t *testing.T) {
+	x1 := enm.Range(1, 5)
+
+	assertResult(t, [][]int{{1, 2, 3}, {2, 3, 4}, {3, 4, 5}}, enm.ToSlice(enm.Window(x1, 3, 1, false)))
+	assertResult(t, [][]int{{1, 2, 3}, {2, 3, 4}, {3, 4, 5}, {4, 5}, {5}}, enm.ToSlice(enm.Window(x1, 3, 1, true)))
+	assertResult(t, [][]int{{1, 2}, {4, 5}}, enm.ToSlice(enm.Window(x1, 2, 3, false)))
+	assertResult(t, [][]int{{1, 2, 3}, {3, 4, 5}}, enm.ToSlice(enm.Window(x1, 3, 2, false)))
+	assertResult(t, [][]int{{1, 2, 3}, {3, 4, 5}, {5}}, enm.ToSlice(enm.Window(x1, 3, 2, true)))
+}
+
+func TestWindow_Itr(t *testing.T) {
+	x1 := itr.Range(1, 5)
+
+	assertResult(t, [][]int{{1, 2, 3}, {2, 3, 4}, {3, 4, 5}}, itr.ToSlice(itr.Window(x1, 3, 1, false)))
+	assertResult(t, [][]int{{1, 2, 3}, {2, 3, 4}, {3, 4, 5}, {4, 5}, {5}}, itr.ToSlice(itr.Window(x1, 3, 1, true)))
+	assertResult(t, [][]int{{1, 2}, {4, 5}}, itr.ToSlice(itr.Window(x1, 2, 3, false)))
+	assertResult(t, [][]int{{1, 2, 3}, {3, 4, 5}}, itr.ToSlice(itr.Window(x1, 3, 2, false)))
+	assertResult(t, [][]int{{1, 2, 3}, {3, 4, 5}, {5}}, itr.ToSlice(itr.Window(x1, 3, 2, true)))
+}
+
+func TestWindowShort_Enm(t *testing.T) {
+	x1 := enm.Range(1, 2)
+
+	assertResult(t, [][]int{}, enm.ToSlice(enm.Window(x1, 3, 1, false)))
+	assertResult(t, [][]int{{1, 2}, {2}}, enm.ToSlice(enm.Window(x1, 3, 1, true)))
+	assertPanics(t, cmn.ErrInvalidWindow, func() { enm.Window(x1, 0, 1, false) })
+	assertPanics(t, cmn.ErrInvalidWindow, func() { enm.Window(x1, 1, 0, false) })
+}
+
+func TestWindowShort_Itr(t *testing.T) {
+	x1 := itr.Range(1, 2)
+
+	assertResult(t, [][]int{}, itr.ToSlice(itr.Window(x1, 3, 1, false)))
+	assertResult(t, [][]int{{1, 2}, {2}}, itr.ToSlice(itr.Window(x1, 3, 1, true)))
+	assertPanics(t, cmn.ErrInvalidWindow, func() { itr.Window(x1, 0, 1, false) })
+	assertPanics(t, cmn.ErrInvalidWindow, func() { itr.Window(x1, 1, 0, false) })
+}
+
+func TestWindowCopiesOut_Itr(t *testing.T) {
+	windows := itr.ToSlice(itr.Window(itr.Range(0, 4), 2, 1, false))
+	windows[0][1] = 99
+
+	assertResult(t, [][]int{{0, 99}, {1, 2}, {2, 3}}, windows)
+}
+
+func TestWindowCancels_Enm(t *testing.T) {
+	before := runtime.NumGoroutine()
+
+	x1 := enm.Window(enm.Generate(0, func(i int) int { return i + 1 }), 2, 2, false)
+	first, _ := enm.First(x1)
+
+	assertResult(t, []int{0, 1}, first)
+	assertGoroutines(t, before)
+}
+
+func TestPairwise_Enm(t *testing.T) {
+	input := []int{1, 4, 9, 16}
+	x1 := enm.Select(enm.Pairwise(enm.FromSlice(&input)), func(p cmn.Pair[int, int]) int { return p.Second - p.First })
+
+	assertResult(t, []int{3, 5, 7}, enm.ToSlice(x1))
+	assertResult(t, []cmn.Pair[int, int]{}, enm.ToSlice(enm.Pairwise(enm.Range(0, 1))))
+}
+
+func TestPairwise_Itr(t *testing.T) {
+	input := []int{1, 4, 9, 16}
+	x1 := itr.Select(itr.Pairwise(itr.FromSlice(&input)), func(p cmn.Pair[int, int]) int { return p.Second - p.First })
+
+	assertResult(t, []int{3, 5, 7}, itr.ToSlice(x1))
+	assertResult(t, []cmn.Pair[int, int]{}, itr.ToSlice(itr.Pairwise(itr.Range(0, 1))))
+}
+
+func TestScan_Enm(t *testing.T) {
+	input := personSlice5()
+	x1 := enm.Scan(enm.FromSlice(&input), "", func(acc string, p Person) string { return acc + p.Name[:1] })
+
+	assertResult(t, []string{"J", "JL", "JLZ", "JLZA", "JLZAR"}, enm.ToSlice(x1))
+	assertResult(t, []string{"J", "JL", "JLZ", "JLZA", "JLZAR"}, enm.ToSlice(x1))
+}
+
+func TestScan_Itr(t *testing.T) {
+	input := personSlice5()
+	x1 := itr.Scan(itr.FromSlice(&input), "", func(acc string, p Person) string { return acc + p.Name[:1] })
+
+	assertResult(t, []string{"J", "JL", "JLZ", "JLZA", "JLZAR"}, itr.ToSlice(x1))
+	assertResult(t, []string{"J", "JL", "JLZ", "JLZA", "JLZAR"}, itr.ToSlice(x1))
+}
+
+func TestScanNoSeed_Enm(t *testing.T) {
+	input := []int{3, 1, 4, 1, 5, 9, 2, 6}
+	x1 := enm.ScanNoSeed(enm.FromSlice(&input), func(acc, x int) int {
+		if x > acc {
+			return x
+		}
+		return acc
+	})
+
+	assertResult(t, []int{3, 3, 4, 4, 5, 9, 9, 9}, enm.ToSlice(x1))
+	assertResult(t, []int{}, enm.ToSlice(enm.ScanNoSeed(enm.Range(0, 0), func(acc, x int) int { return acc + x })))
+}
+
+func TestScanNoSeed_Itr(t *testing.T) {
+	input := []int{3, 1, 4, 1, 5, 9, 2, 6}
+	x1 := itr.ScanNoSeed(itr.FromSlice(&input), func(acc, x int) int {
+		if x > acc {
+			return x
+		}
+		return acc
+	})
+
+	assertResult(t, []int{3, 3, 4, 4, 5, 9, 9, 9}, itr.ToSlice(x1))
+	assertResult(t, []int{}, itr.ToSlice(itr.ScanNoSeed(itr.Range(0, 0), func(acc, x int) int { return acc + x })))
+}
+
+func TestScanInfinite_Enm(t *testing.T) {
+	before := runtime.NumGoroutine()
+
+	x1 := enm.Scan(enm.Generate(1, func(i int) int { return i + 1 }), 0, func(acc, x int) int { return acc + x })
+
+	assertResult(t, []int{1, 3, 6, 10}, enm.ToSlice(enm.Take(x1, 4)))
+	assertGoroutines(t, before)
+}
+
+func TestScanInfinite_Itr(t *testing.T) {
+	x1 := itr.Scan(itr.Generate(1, func(i int) int { return i + 1 }), 0, func(acc, x int) int { return acc + x })
+
+	assertResult(t, []int{1, 3, 6, 10}, itr.ToSlice(itr.Take(x1, 4)))
+}
+
+func TestOrderByKeysOnce_Enm(t *testing.T) {
+	calls := 0
+	key := func(i int) int {
+		calls++
+		return (i * 7919) % 1000
+	}
+	x1 := enm.ThenBy(enm.OrderByDescending(enm.Range(0, 1000), key), key)
+
+	assertResult(t, 1000, len(enm.ToSlice(x1)))
+	assertResult(t, 2000, calls)
+	calls = 0
+	first, _ := enm.First(x1)
+	assertResult(t, 321, first)
+	assertResult(t, 2000, calls)
+}
+
+func TestOrderByKeysOnce_Itr(t *testing.T) {
+	calls := 0
+	key := func(i int) int {
+		calls++
+		return (i * 7919) % 1000
+	}
+	x1 := itr.ThenBy(itr.OrderByDescending(itr.Range(0, 1000), key), key)
+
+	assertResult(t, 1000, len(itr.ToSlice(x1)))
+	assertResult(t, 2000, calls)
+	calls = 0
+	first, _ := itr.First(x1)
+	assertResult(t, 321, first)
+	assertResult(t, 2000, calls)
+}